@@ -0,0 +1,212 @@
+package i18n
+
+import (
+	"regexp"
+	"strings"
+)
+
+// AddressField represents a field of postal address.
+type AddressField byte
+
+// Address Field List.
+const (
+	AddressRecipient    AddressField = 'N'
+	AddressOrganization AddressField = 'O'
+	AddressStreet       AddressField = 'A'
+	AddressDistrict     AddressField = 'D'
+	AddressCity         AddressField = 'C'
+	AddressSubdivision  AddressField = 'S'
+	AddressPostalCode   AddressField = 'Z'
+)
+
+// Address represents a postal address.
+type Address struct {
+	Recipient    string
+	Organization string
+	Street       string // street address (lines are separated by \n)
+	District     string // dependent locality (e.g. suburb, neighbourhood)
+	City         string
+	Subdivision  string // code (e.g. US-CA) or name of subdivision
+	PostalCode   string
+}
+
+// value returns the value of field in the address with given format.
+func (a *Address) value(field AddressField, country *Country, format *addressFormat) string {
+	var value string
+	switch field {
+	case AddressRecipient:
+		value = a.Recipient
+	case AddressOrganization:
+		value = a.Organization
+	case AddressStreet:
+		value = a.Street
+	case AddressDistrict:
+		value = a.District
+	case AddressCity:
+		value = a.City
+	case AddressSubdivision:
+		value = a.Subdivision
+		if subdivision, ok := LookupSubdivision(value); ok && subdivision.Country.Equal(country) {
+			if format.SubdivisionCode {
+				value = subdivision.Code[strings.Index(subdivision.Code, "-")+1:]
+			} else {
+				value = subdivision.Name
+			}
+		}
+	case AddressPostalCode:
+		value = a.PostalCode
+	}
+	value = strings.TrimSpace(value)
+	if strings.ContainsRune(format.Uppercase, rune(field)) {
+		value = strings.ToUpper(value)
+	}
+	return value
+}
+
+// addressFormat represents the postal address format of a country.
+type addressFormat struct {
+	Pattern          string // pattern in latin script
+	LocalPattern     string // pattern in local script (used if the language of culture is the local language)
+	Language         string // local language code
+	Uppercase        string // fields should be in upper case
+	SubdivisionCode  bool   // uses the code instead of name of subdivision (e.g. CA instead of California)
+	PostalCodeLabel  string
+	SubdivisionLabel string
+}
+
+// pattern returns the pattern for culture.
+func (af *addressFormat) pattern(culture *Culture) string {
+	if len(af.LocalPattern) > 0 && culture != nil && culture.Language != nil && strings.EqualFold(culture.Language.Code, af.Language) {
+		return af.LocalPattern
+	}
+	return af.Pattern
+}
+
+// defaultAddressFormat is used for the country without address format.
+var defaultAddressFormat = &addressFormat{
+	Pattern: "%N\n%O\n%A\n%D\n%Z %C\n%S",
+}
+
+// postalCodes contains the compiled postal code patterns (nil for the countries without postal codes).
+var postalCodes = make(map[string]*regexp.Regexp, len(postalCodePatterns))
+
+func init() {
+	for code, pattern := range postalCodePatterns {
+		if len(pattern) == 0 {
+			postalCodes[code] = nil
+			continue
+		}
+		postalCodes[code] = regexp.MustCompile(`^(?:` + pattern + `)$`)
+	}
+}
+
+// lookupAddressFormat returns the address format of the country.
+func lookupAddressFormat(country *Country) *addressFormat {
+	if format, ok := addressFormats[country.Alpha2Code]; ok {
+		return format
+	}
+	return defaultAddressFormat
+}
+
+// AddressFields returns the fields of postal address in the order of the country (for culture).
+func (c *Country) AddressFields(culture *Culture) []AddressField {
+	pattern := lookupAddressFormat(c).pattern(culture)
+	var fields []AddressField
+	for i := 0; i < len(pattern)-1; i++ {
+		if pattern[i] == '%' {
+			fields = append(fields, AddressField(pattern[i+1]))
+			i += 1
+		}
+	}
+	return fields
+}
+
+// AddressFieldLabel returns the (English) label of postal address field in the country, e.g. ZIP code, state.
+func (c *Country) AddressFieldLabel(field AddressField) string {
+	format := lookupAddressFormat(c)
+	switch field {
+	case AddressRecipient:
+		return "name"
+	case AddressOrganization:
+		return "organization"
+	case AddressStreet:
+		return "street address"
+	case AddressDistrict:
+		return "district"
+	case AddressCity:
+		return "city"
+	case AddressSubdivision:
+		if len(format.SubdivisionLabel) > 0 {
+			return format.SubdivisionLabel
+		}
+		if list := subdivisionTableCountry[c.Alpha2Code]; len(list) > 0 {
+			return list[0].Type
+		}
+		return "province"
+	case AddressPostalCode:
+		if len(format.PostalCodeLabel) > 0 {
+			return format.PostalCodeLabel
+		}
+		return "postal code"
+	}
+	return ""
+}
+
+// HasPostalCode reports whether the country uses postal codes.
+func (c *Country) HasPostalCode() bool {
+	return postalCodes[c.Alpha2Code] != nil
+}
+
+// IsValidPostalCode reports whether the postal code is valid in the country.
+// It always returns true if the country does not use postal codes,
+// and returns false if the postal code format of country is unknown (e.g. an invalid country).
+func (c *Country) IsValidPostalCode(code string) bool {
+	postalCode, ok := postalCodes[c.Alpha2Code]
+	if !ok {
+		return false
+	}
+	if postalCode == nil {
+		return true
+	}
+	return postalCode.MatchString(strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// FormatAddress formats the postal address with the conventions of the country.
+// The culture is the culture of sender, the country name is appended if the culture is nil or the culture is of different country.
+func (c *Country) FormatAddress(address *Address, culture *Culture) string {
+	format := lookupAddressFormat(c)
+	pattern := format.pattern(culture)
+	var buf strings.Builder
+	for i := 0; i < len(pattern); i++ {
+		if pattern[i] == '%' && i < len(pattern)-1 {
+			buf.WriteString(address.value(AddressField(pattern[i+1]), c, format))
+			i += 1
+			continue
+		}
+		buf.WriteByte(pattern[i])
+	}
+
+	var lines []string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		// cleans the separators of empty fields
+		value := strings.Join(strings.Fields(line), " ")
+		value = strings.Replace(value, " ,", ",", -1)
+		value = strings.Trim(value, " ,-")
+		if len(value) > 0 && value != "〒" {
+			lines = append(lines, value)
+		}
+	}
+
+	if culture == nil || culture.Country == nil || !culture.Country.Equal(c) {
+		var name string
+		if culture != nil {
+			name = c.Name.CultureValue(culture)
+		}
+		if len(name) == 0 {
+			name = c.Alpha2Code
+		}
+		lines = append(lines, strings.ToUpper(name))
+	}
+
+	return strings.Join(lines, "\n")
+}
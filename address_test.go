@@ -0,0 +1,76 @@
+package i18n
+
+import (
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestAddress(t *testing.T) {
+	enUS, _ := LookupCulture("en-US")
+	jaJP, _ := LookupCulture("ja-JP")
+	us, _ := LookupCountry(nil, "US")
+	de, _ := LookupCountry(nil, "DE")
+	jp, _ := LookupCountry(nil, "JP")
+	gb, _ := LookupCountry(nil, "GB")
+	testing2.AssertEqual(t, jaJP.Language.Code, "ja")
+	testing2.AssertEqual(t, jaJP.Currency.Code, "JPY")
+
+	// test FormatAddress
+	addr := &Address{
+		Recipient:   "John Smith",
+		Street:      "1600 Amphitheatre Parkway\nBuilding 43",
+		City:        "Mountain View",
+		Subdivision: "US-CA",
+		PostalCode:  "94043",
+	}
+	testing2.AssertEqual(t, us.FormatAddress(addr, enUS), "John Smith\n1600 Amphitheatre Parkway\nBuilding 43\nMOUNTAIN VIEW, CA 94043")
+	addr = &Address{
+		Recipient:    "Max Mustermann",
+		Organization: "Musterfirma GmbH",
+		Street:       "Musterstraße 12",
+		City:         "Berlin",
+		PostalCode:   "10115",
+	}
+	testing2.AssertEqual(t, de.FormatAddress(addr, enUS), "Max Mustermann\nMusterfirma GmbH\nMusterstraße 12\n10115 Berlin\nDE")
+	addr = &Address{
+		Recipient:   "山田太郎",
+		Street:      "丸の内1-1-1",
+		City:        "千代田区",
+		Subdivision: "東京都",
+		PostalCode:  "100-0005",
+	}
+	testing2.AssertEqual(t, jp.FormatAddress(addr, jaJP), "〒100-0005\n東京都千代田区\n丸の内1-1-1\n山田太郎")
+	addr = &Address{
+		Recipient:   "Taro Yamada",
+		Street:      "1-1-1 Marunouchi",
+		City:        "Chiyoda-ku",
+		Subdivision: "JP-13",
+		PostalCode:  "100-0005",
+	}
+	testing2.AssertEqual(t, jp.FormatAddress(addr, enUS), "Taro Yamada\n1-1-1 Marunouchi, Chiyoda-ku\nTOKYO\n100-0005\nJP")
+
+	// test AddressFields & AddressFieldLabel
+	testing2.AssertEqual(t, us.AddressFields(enUS), []AddressField{AddressRecipient, AddressOrganization, AddressStreet, AddressCity, AddressSubdivision, AddressPostalCode})
+	testing2.AssertEqual(t, us.AddressFieldLabel(AddressPostalCode), "ZIP code")
+	testing2.AssertEqual(t, us.AddressFieldLabel(AddressSubdivision), "state")
+	testing2.AssertEqual(t, jp.AddressFieldLabel(AddressSubdivision), "prefecture")
+
+	// test IsValidPostalCode
+	testing2.AssertEqual(t, us.IsValidPostalCode("94043-1351"), true)
+	testing2.AssertEqual(t, us.IsValidPostalCode("9404"), false)
+	testing2.AssertEqual(t, gb.IsValidPostalCode("sw1a 1aa"), true)
+	testing2.AssertEqual(t, de.IsValidPostalCode("1011"), false)
+	hk, _ := LookupCountry(nil, "HK")
+	testing2.AssertEqual(t, hk.HasPostalCode(), false)
+	testing2.AssertEqual(t, hk.IsValidPostalCode(""), true)
+	cz, _ := LookupCountry(nil, "CZ")
+	testing2.AssertEqual(t, cz.HasPostalCode(), true)
+	testing2.AssertEqual(t, cz.IsValidPostalCode("110 00"), true)
+	testing2.AssertEqual(t, cz.IsValidPostalCode("garbage!!"), false)
+	testing2.AssertEqual(t, new(Country).IsValidPostalCode("12345"), false) // unknown format
+	for _, country := range AllCountries() {
+		_, ok := postalCodePatterns[country.Alpha2Code]
+		testing2.AssertEqual(t, ok, true)
+	}
+}
@@ -0,0 +1,484 @@
+package i18n
+
+import (
+	"bytes"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// collationElement represents a collation element (weights of three levels) of Unicode Collation Algorithm.
+type collationElement struct {
+	Primary   uint32 // base character
+	Secondary uint16 // diacritic
+	Tertiary  uint8  // case
+}
+
+// Weights of collation elements.
+const (
+	collationWeightVariable uint32 = 0x00000100 // whitespaces, punctuations & symbols
+	collationWeightDigit    uint32 = 0x00100000
+	collationWeightLatin    uint32 = 0x00200000
+	collationWeightOther    uint32 = 0x00300000 // other scripts (in code point order)
+	collationWeightGap      uint32 = 0x10       // gap between latin letters (for tailoring)
+
+	collationSecondaryBase uint16 = 0x20
+	collationTertiaryLower uint8  = 0x01
+	collationTertiaryUpper uint8  = 0x02
+)
+
+// Collator compares strings with the (simplified) Unicode Collation Algorithm.
+// Strings are compared by base characters first, then diacritics, then case,
+// so "Österreich" sorts with "O" instead of after "Z" (unless the culture tailors it).
+// The canonically equivalent strings (e.g. "é" and "e" + U+0301) are equal.
+type Collator struct {
+	tailoring map[[2]rune]uint32 // tailored primary weights of (lower-cased) letters ({letter, 0}) or contractions
+}
+
+// NewCollator returns the collator with the tailoring of culture (language).
+// It returns the root collator if culture is nil or the language of culture has no tailoring.
+func NewCollator(culture *Culture) *Collator {
+	if culture == nil || culture.Language == nil {
+		return rootCollator
+	}
+	return newLanguageCollator(culture.Language)
+}
+
+// newLanguageCollator returns the collator of language.
+func newLanguageCollator(language *Language) *Collator {
+	if language == nil {
+		return rootCollator
+	}
+	if collator, ok := collatorTable[strings.ToLower(language.Code)]; ok {
+		return collator
+	}
+	return rootCollator
+}
+
+// Compare compares x and y and returns:
+// -1 if x < y
+//  0 if x == y
+// +1 if x > y
+func (c *Collator) Compare(x, y string) int {
+	if x == y {
+		return 0
+	}
+
+	for level := 1; level <= 3; level++ {
+		ix := collationIterator{collator: c, s: x}
+		iy := collationIterator{collator: c, s: y}
+		for {
+			ex, okx := ix.next()
+			ey, oky := iy.next()
+			if !okx || !oky {
+				switch {
+				case okx:
+					return 1
+				case oky:
+					return -1
+				}
+				break
+			}
+			if r := compareCollationElement(ex, ey, level); r != 0 {
+				return r
+			}
+		}
+	}
+
+	return 0
+}
+
+// Less reports whether x sorts before y.
+func (c *Collator) Less(x, y string) bool {
+	return c.Compare(x, y) < 0
+}
+
+// Key returns the sort key of string.
+// Comparing the keys byte-wise is same as comparing the strings with Compare,
+// it's faster to compare the keys if the strings are compared many times (e.g. sorting).
+func (c *Collator) Key(s string) []byte {
+	return c.appendKey(make([]byte, 0, len(s)*8+6), s)
+}
+
+// appendKey appends the sort key of string to dst and returns the extended buffer.
+// The key consists of the weights of three levels, the levels are separated by zeros (lower than any weight).
+func (c *Collator) appendKey(dst []byte, s string) []byte {
+	var buf [64]collationElement
+	elements := buf[:0]
+	it := collationIterator{collator: c, s: s}
+	for e, ok := it.next(); ok; e, ok = it.next() {
+		elements = append(elements, e)
+	}
+
+	for _, e := range elements {
+		dst = append(dst, byte(e.Primary>>24), byte(e.Primary>>16), byte(e.Primary>>8), byte(e.Primary))
+	}
+	dst = append(dst, 0, 0, 0, 0)
+	for _, e := range elements {
+		dst = append(dst, byte(e.Secondary>>8), byte(e.Secondary))
+	}
+	dst = append(dst, 0, 0)
+	for _, e := range elements {
+		dst = append(dst, e.Tertiary)
+	}
+	return dst
+}
+
+// Sort sorts the strings.
+func (c *Collator) Sort(list []string) {
+	c.sortByKeys(len(list), func(i int) (string, bool) {
+		return list[i], true
+	}, func(i, j int) {
+		list[i], list[j] = list[j], list[i]
+	})
+}
+
+// sortByKeys sorts a list of n elements stably by the strings of elements (value returns false if the element is nil, nil sorts first).
+// The sort keys of strings are computed once for each element, then the list is rearranged with swap.
+func (c *Collator) sortByKeys(n int, value func(i int) (string, bool), swap func(i, j int)) {
+	var buf []byte
+	offsets := make([]int, n+1)
+	for i := 0; i < n; i++ {
+		if s, ok := value(i); ok {
+			if buf == nil {
+				buf = make([]byte, 0, n*(len(s)*7+8))
+			}
+			buf = append(buf, 1) // not nil
+			buf = c.appendKey(buf, s)
+		}
+		offsets[i+1] = len(buf)
+	}
+	sorter := &collationKeySorter{
+		keys:  make([][]byte, n),
+		order: make([]int, n),
+	}
+	for i := range sorter.keys {
+		sorter.keys[i] = buf[offsets[i]:offsets[i+1]]
+		sorter.order[i] = i
+	}
+	sort.Sort(sorter)
+
+	// rearrange the list by the order
+	at, where := offsets[:n], make([]int, n) // original index of element at position & position of element of original index
+	for i := range at {
+		at[i], where[i] = i, i
+	}
+	for i, index := range sorter.order {
+		if j := where[index]; j != i {
+			swap(i, j)
+			at[j], where[at[i]] = at[i], j
+			at[i], where[index] = index, i
+		}
+	}
+}
+
+// collationKeySorter sorts the indexes of a list by the sort keys of elements.
+// It's stable since the equal keys are sorted by indexes.
+type collationKeySorter struct {
+	keys  [][]byte // keys of elements
+	order []int    // sorted indexes of elements
+}
+
+// Len is part of sort.Interface.
+func (cks *collationKeySorter) Len() int {
+	return len(cks.order)
+}
+
+// Swap is part of sort.Interface.
+func (cks *collationKeySorter) Swap(i, j int) {
+	cks.order[i], cks.order[j] = cks.order[j], cks.order[i]
+}
+
+// Less is part of sort.Interface.
+func (cks *collationKeySorter) Less(i, j int) bool {
+	x, y := cks.order[i], cks.order[j]
+	if r := bytes.Compare(cks.keys[x], cks.keys[y]); r != 0 {
+		return r < 0
+	}
+	return x < y
+}
+
+// compareCollationElement compares the weight of level of two collation elements.
+func compareCollationElement(x, y collationElement, level int) int {
+	var wx, wy uint32
+	switch level {
+	case 1:
+		wx, wy = x.Primary, y.Primary
+	case 2:
+		wx, wy = uint32(x.Secondary), uint32(y.Secondary)
+	default:
+		wx, wy = uint32(x.Tertiary), uint32(y.Tertiary)
+	}
+	switch {
+	case wx < wy:
+		return -1
+	case wx > wy:
+		return 1
+	}
+	return 0
+}
+
+// collationIterator iterates the collation elements of a string (without allocation).
+type collationIterator struct {
+	collator *Collator
+	s        string
+	pos      int                 // position of next character
+	elements [4]collationElement // elements of current character (expansion)
+	n, i     int                 // number of elements & index of next element
+}
+
+// next returns the next collation element, it returns false if no more element.
+func (it *collationIterator) next() (collationElement, bool) {
+	for it.i >= it.n {
+		if it.pos >= len(it.s) {
+			return collationElement{}, false
+		}
+		// fast path of basic latin letters & digits (not followed by combining marks)
+		if len(it.collator.tailoring) == 0 && (it.pos+1 == len(it.s) || it.s[it.pos+1] < utf8.RuneSelf) {
+			b := rune(it.s[it.pos])
+			switch {
+			case b >= 'a' && b <= 'z':
+				it.pos += 1
+				return collationElement{collationWeightLatin + uint32(b-'a')*collationWeightGap, collationSecondaryBase, collationTertiaryLower}, true
+			case b >= 'A' && b <= 'Z':
+				it.pos += 1
+				return collationElement{collationWeightLatin + uint32(b-'A')*collationWeightGap, collationSecondaryBase, collationTertiaryUpper}, true
+			case b >= '0' && b <= '9':
+				it.pos += 1
+				return collationElement{collationWeightDigit + uint32(b-'0'), collationSecondaryBase, collationTertiaryLower}, true
+			}
+		}
+		it.read()
+	}
+	e := it.elements[it.i]
+	it.i += 1
+	return e, true
+}
+
+// push appends an element of current character.
+func (it *collationIterator) push(primary uint32, secondary uint16, tertiary uint8) {
+	if it.n < len(it.elements) {
+		it.elements[it.n] = collationElement{primary, secondary, tertiary}
+		it.n += 1
+	}
+}
+
+// read reads the elements of next character.
+func (it *collationIterator) read() {
+	it.n, it.i = 0, 0
+	it.character()
+	it.marks()
+}
+
+// character reads the elements of next character (without the following combining marks).
+func (it *collationIterator) character() {
+	lower, tertiary, size := it.letter(it.pos)
+	it.pos += size
+
+	// tailoring (contraction first)
+	if tailoring := it.collator.tailoring; len(tailoring) > 0 {
+		if it.pos < len(it.s) {
+			next, _, size := it.letter(it.pos)
+			if w, ok := tailoring[[2]rune{lower, next}]; ok {
+				it.pos += size
+				it.push(w, collationSecondaryBase, tertiary)
+				return
+			}
+		}
+		if w, ok := tailoring[[2]rune{lower}]; ok {
+			it.push(w, collationSecondaryBase, tertiary)
+			return
+		}
+	}
+
+	switch {
+	case lower >= 'a' && lower <= 'z':
+		it.push(collationWeightLatin+uint32(lower-'a')*collationWeightGap, collationSecondaryBase, tertiary)
+	case lower >= '0' && lower <= '9':
+		it.push(collationWeightDigit+uint32(lower-'0'), collationSecondaryBase, tertiary)
+	case lower < utf8.RuneSelf:
+		if unicode.IsSpace(lower) || unicode.IsPunct(lower) || unicode.IsSymbol(lower) {
+			it.push(collationWeightVariable+uint32(lower), collationSecondaryBase, tertiary)
+		}
+	case unicode.Is(unicode.Mn, lower):
+		// combining mark without base character (ignorable)
+	default:
+		// latin letters with diacritic (expansion)
+		if base, ok := foldTable[lower]; ok {
+			for _, b := range base {
+				it.push(collationWeightLatin+uint32(b-'a')*collationWeightGap, uint16(lower), tertiary)
+			}
+			return
+		}
+
+		switch {
+		case unicode.IsDigit(lower):
+			it.push(collationWeightDigit+uint32(digitValue(lower)), collationSecondaryBase, tertiary)
+		case unicode.IsLetter(lower):
+			it.push(collationWeightOther+uint32(lower), collationSecondaryBase, tertiary)
+		case unicode.IsSpace(lower) || unicode.IsPunct(lower) || unicode.IsSymbol(lower):
+			it.push(collationWeightVariable+uint32(lower&0xFFFF), collationSecondaryBase, tertiary)
+		}
+	}
+}
+
+// letter returns the lower-cased character at the position (composed with the following combining marks if possible),
+// the tertiary weight (case) and the size of character.
+func (it *collationIterator) letter(pos int) (rune, uint8, int) {
+	r, size := rune(it.s[pos]), 1
+	if r >= utf8.RuneSelf {
+		r, size = utf8.DecodeRuneInString(it.s[pos:])
+	}
+
+	lower := r
+	switch {
+	case r >= 'A' && r <= 'Z':
+		lower = r + 'a' - 'A'
+	case r >= utf8.RuneSelf:
+		lower = unicode.ToLower(r)
+	}
+	tertiary := collationTertiaryLower
+	if lower != r {
+		tertiary = collationTertiaryUpper
+	}
+
+	// canonical composition, e.g. e + U+0301 = é
+	for pos+size < len(it.s) && it.s[pos+size] >= utf8.RuneSelf {
+		mark, markSize := utf8.DecodeRuneInString(it.s[pos+size:])
+		composed, ok := collationCompositions[[2]rune{lower, mark}]
+		if !ok {
+			break
+		}
+		lower = composed
+		size += markSize
+	}
+
+	return lower, tertiary, size
+}
+
+// marks adds the weights of following combining marks (which cannot be composed) to the secondary weight of last element.
+func (it *collationIterator) marks() {
+	for it.pos < len(it.s) && it.s[it.pos] >= utf8.RuneSelf {
+		mark, size := utf8.DecodeRuneInString(it.s[it.pos:])
+		if !unicode.Is(unicode.Mn, mark) {
+			return
+		}
+		if it.n > 0 {
+			it.elements[it.n-1].Secondary += uint16(mark & 0xFF)
+		}
+		it.pos += size
+	}
+}
+
+// digitValue returns the numeric value of the decimal digit.
+func digitValue(r rune) int {
+	// decimal digits are encoded in contiguous ranges of 10 code points from zero
+	v := 0
+	for v < 9 && unicode.IsDigit(r-rune(v)-1) {
+		v += 1
+	}
+	return v
+}
+
+// primaryWeight returns the primary weight of the (lower-cased) latin letter or contraction.
+func (c *Collator) primaryWeight(key [2]rune) uint32 {
+	if w, ok := c.tailoring[key]; ok {
+		return w
+	}
+	it := collationIterator{collator: rootCollator, s: string(key[0])}
+	if e, ok := it.next(); ok {
+		return e.Primary
+	}
+	return 0
+}
+
+// tailoringKey returns the key of tailoring of the letter or contraction.
+func tailoringKey(s string) [2]rune {
+	var key [2]rune
+	for i, r := range []rune(strings.TrimSpace(s)) {
+		if i < len(key) {
+			key[i] = r
+		}
+	}
+	return key
+}
+
+// collationCompositions represents the canonical compositions of latin letters with combining marks (key: letter, mark).
+var collationCompositions = newCollationCompositions()
+
+func newCollationCompositions() map[[2]rune]rune {
+	compositions := make(map[[2]rune]rune)
+	for mark, letters := range map[rune]string{
+		0x0300: "àèìòùǹẁỳ",       // grave
+		0x0301: "áéíóúýćĺńŕśźǵẃ", // acute
+		0x0302: "âêîôûĉĝĥĵŝŵŷ",   // circumflex
+		0x0303: "ãñõĩũỹ",         // tilde
+		0x0304: "āēīōū",          // macron
+		0x0306: "ăĕğĭŏŭ",         // breve
+		0x0307: "ċėġż",           // dot above
+		0x0308: "äëïöüÿẅ",        // diaeresis
+		0x030A: "åů",             // ring above
+		0x030B: "őű",             // double acute
+		0x030C: "ǎčďěǐľňǒřšťǔž",  // caron
+		0x0326: "șț",             // comma below
+		0x0327: "çģķļņŗşţ",       // cedilla
+		0x0328: "ąęįų",           // ogonek
+	} {
+		for _, letter := range letters {
+			base := foldTable[letter]
+			if len(base) != 1 {
+				continue // not in the fold table
+			}
+			compositions[[2]rune{rune(base[0]), mark}] = letter
+		}
+	}
+	return compositions
+}
+
+// collationTailorings represents the tailoring rules of languages.
+// Each rule is a chain of letters (or contractions) where every element sorts after the previous one as a new base letter,
+// letters separated by comma share the same primary weight (e.g. "z < å < ä,æ < ö,ø").
+var collationTailorings = map[string][]string{
+	"cs": {"c < č", "h < ch", "r < ř", "s < š", "z < ž"},
+	"da": {"z < æ,ä < ø,ö < å"},
+	"es": {"n < ñ"},
+	"et": {"s < š < z < ž", "w < õ < ä < ö < ü"},
+	"fi": {"z < å < ä,æ < ö,ø"},
+	"hr": {"c < č < ć", "d < dž < đ", "l < lj", "n < nj", "s < š", "z < ž"},
+	"is": {"a < á", "d < ð", "e < é", "i < í", "o < ó", "u < ú", "y < ý", "z < þ < æ < ö"},
+	"nb": {"z < æ,ä < ø,ö < å"},
+	"nn": {"z < æ,ä < ø,ö < å"},
+	"no": {"z < æ,ä < ø,ö < å"},
+	"pl": {"a < ą", "c < ć", "e < ę", "l < ł", "n < ń", "o < ó", "s < ś", "z < ź < ż"},
+	"ro": {"a < ă < â", "i < î", "s < ș,ş", "t < ț,ţ"},
+	"sk": {"a < ä", "c < č", "h < ch", "o < ô", "r < ř", "s < š", "z < ž"},
+	"sv": {"z < å < ä,æ < ö,ø"},
+	"tr": {"c < ç", "g < ğ", "h < ı", "o < ö", "s < ş", "u < ü"},
+}
+
+var (
+	rootCollator  = &Collator{}
+	collatorTable map[string]*Collator // key: language code
+)
+
+func init() {
+	collatorTable = make(map[string]*Collator)
+	for code, rules := range collationTailorings {
+		collator := &Collator{
+			tailoring: make(map[[2]rune]uint32),
+		}
+		for _, rule := range rules {
+			chain := strings.Split(rule, "<")
+			weight := collator.primaryWeight(tailoringKey(chain[0]))
+			for _, letters := range chain[1:] {
+				weight += 1
+				for _, letter := range strings.Split(letters, ",") {
+					collator.tailoring[tailoringKey(letter)] = weight
+				}
+			}
+		}
+
+		collatorTable[code] = collator
+	}
+}
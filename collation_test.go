@@ -0,0 +1,88 @@
+package i18n
+
+import (
+	"bytes"
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestCollator(t *testing.T) {
+	deDE, _ := LookupCulture("de-DE")
+	svSE, _ := LookupCulture("sv-SE")
+	esES, _ := LookupCulture("es-ES")
+	csCZ, _ := LookupCulture("cs-CZ")
+
+	data := map[*Culture][]string{
+		deDE: {"Zypern", "Österreich", "Oman", "Ägypten", "Albanien", "Ozeanien"},
+		svSE: {"Österrike", "Zambia", "Åland", "Ägypten", "Albanien"},
+		esES: {"Nicaragua", "Ñandú", "Noruega", "Namibia"},
+		csCZ: {"Chile", "Čína", "Chorvatsko", "Cypr", "Honduras", "Indie"},
+		nil:  {"b", "B", "a", "á", "A", "résumé", "resume", "Resume", "2", "10", "a b"},
+	}
+	expected := map[*Culture][]string{
+		deDE: {"Ägypten", "Albanien", "Oman", "Österreich", "Ozeanien", "Zypern"},
+		svSE: {"Albanien", "Zambia", "Åland", "Ägypten", "Österrike"},
+		esES: {"Namibia", "Nicaragua", "Noruega", "Ñandú"},
+		csCZ: {"Cypr", "Čína", "Honduras", "Chile", "Chorvatsko", "Indie"},
+		nil:  {"10", "2", "a", "A", "á", "a b", "b", "B", "resume", "Resume", "résumé"},
+	}
+	for culture, list := range data {
+		NewCollator(culture).Sort(list)
+		testing2.AssertEqual(t, list, expected[culture])
+	}
+
+	collator := NewCollator(deDE)
+	testing2.AssertEqual(t, collator.Compare("Straße", "Strasse") > 0, true)
+	testing2.AssertEqual(t, collator.Compare("Strasse", "Strassf") < 0, true)
+	testing2.AssertEqual(t, collator.Compare("café", "cafe") > 0, true)
+	testing2.AssertEqual(t, collator.Compare("abc", "abc"), 0)
+
+	// canonical equivalence (NFC vs NFD)
+	testing2.AssertEqual(t, NewCollator(nil).Compare("caf\u00e9", "cafe\u0301"), 0)
+	testing2.AssertEqual(t, NewCollator(esES).Compare("\u00d1and\u00fa", "N\u0303andu\u0301"), 0)
+	testing2.AssertEqual(t, NewCollator(esES).Compare("N\u0303andu\u0301", "Noruega") > 0, true)
+
+	// sort keys
+	for _, pair := range [][2]string{{"a", "A"}, {"résumé", "resume"}, {"Straße", "Strasse"}, {"10", "2"}, {"a b", "ab"}} {
+		x, y := collator.Key(pair[0]), collator.Key(pair[1])
+		testing2.AssertEqual(t, bytes.Compare(x, y), collator.Compare(pair[0], pair[1]))
+	}
+
+	// test SortByName
+	german, _ := LookupLanguage("de")
+	names := map[string]string{"AT": "Österreich", "CY": "Zypern", "OM": "Oman"}
+	countries := make(Countries, 0, len(names))
+	for code, name := range names {
+		country, _ := LookupCountry(nil, code)
+		country.Name.SetValue(german, name)
+		countries = append(countries, country)
+	}
+	defer func() {
+		for _, country := range countries {
+			country.Name.SetValue(german, "")
+		}
+	}()
+	countries.SortByName(german)
+	testing2.AssertEqual(t, []string{countries[0].Alpha2Code, countries[1].Alpha2Code, countries[2].Alpha2Code}, []string{"OM", "AT", "CY"})
+	countries.SortByNameWithCulture(svSE) // names in Swedish are empty
+	testing2.AssertEqual(t, len(countries), 3)
+
+	// nil culture (root collation & empty names)
+	all := AllCountries()
+	all.SortByNameWithCulture(nil)
+	testing2.AssertEqual(t, len(all), len(AllCountries()))
+	testing2.AssertEqual(t, CountryByNameWithCulture(nil)(countries[0], countries[1]), 0)
+	AllCultures().SortByNameWithCulture(nil)
+	AllCurrencies().SortByNameWithCulture(nil)
+	AllLanguages().SortByNameWithCulture(nil)
+
+	// nil language (root collation & empty names)
+	all.SortByName(nil)
+	testing2.AssertEqual(t, len(all), len(AllCountries()))
+	testing2.AssertEqual(t, CountryByName(nil)(countries[0], countries[1]), 0)
+	AllCultures().SortByName(nil)
+	AllCurrencies().SortByName(nil)
+	AllLanguages().SortByName(nil)
+	AllRegions().SortByName(nil)
+}
@@ -0,0 +1,26 @@
+package i18n
+
+// compareNil compares x and y by nil (nil sorts first) and reports whether the result is decided
+// (it's not decided if both are not nil).
+func compareNil(xIsNil, yIsNil bool) (int, bool) {
+	switch {
+	case xIsNil && yIsNil:
+		return 0, true
+	case xIsNil:
+		return -1, true
+	case yIsNil:
+		return 1, true
+	}
+	return 0, false
+}
+
+// compareString compares two strings in byte-wise order.
+func compareString(x, y string) int {
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
@@ -7,11 +7,27 @@ import (
 
 // Country represents a country. ISO 3166-1
 type Country struct {
-	Alpha2Code  string // ISO alpha-2 country code
-	Alpha3Code  string // ISO alpha-3 country code
-	NumericCode string // ISO numeric country code
-	Name        *MultiLanguageString
-	Aliases     *MultiLanguageStringArray
+	Alpha2Code   string // ISO alpha-2 country code
+	Alpha3Code   string // ISO alpha-3 country code
+	NumericCode  string // ISO numeric country code
+	Name         *MultiLanguageString
+	Aliases      *MultiLanguageStringArray
+	CallingCodes []string // international calling codes (ITU-T E.164), e.g. +44
+	TLD          string   // country code top-level domain, e.g. .uk (empty if not delegated)
+}
+
+// Flag returns the emoji flag of the country (composed by regional indicator symbols).
+func (c *Country) Flag() string {
+	if len(c.Alpha2Code) != 2 {
+		return ""
+	}
+
+	flag := make([]rune, 2)
+	for i, r := range strings.ToUpper(c.Alpha2Code) {
+		flag[i] = 0x1F1E6 + (r - 'A')
+	}
+
+	return string(flag)
 }
 
 // Eaual reports whether two countries are same.
@@ -25,35 +41,103 @@ type Countries []*Country
 
 // SortByCode sorts the list by code.
 func (c Countries) SortByCode() {
-	var byCode CountryLessFunc = func(c1, c2 *Country) bool {
-		return c1.Alpha2Code < c2.Alpha2Code
+	c.SortBy(CountryByCode)
+}
+
+// SortByName sorts the list by name with the collation of language.
+func (c Countries) SortByName(language *Language) {
+	c.sortByName(func(name *MultiLanguageString) string {
+		return name.Value(language)
+	}, newLanguageCollator(language))
+}
+
+// SortByNameWithCulture sorts the list by name of culture (falls back to the name in its language) with the collation of culture.
+func (c Countries) SortByNameWithCulture(culture *Culture) {
+	c.sortByName(func(name *MultiLanguageString) string {
+		return name.CultureValue(culture)
+	}, NewCollator(culture))
+}
+
+// sortByName sorts the list stably by name, the sort keys of names are computed once.
+func (c Countries) sortByName(value func(name *MultiLanguageString) string, collator *Collator) {
+	collator.sortByKeys(len(c), func(i int) (string, bool) {
+		if c[i] == nil {
+			return "", false
+		}
+		return value(c[i].Name), true
+	}, func(i, j int) {
+		c[i], c[j] = c[j], c[i]
+	})
+}
+
+// SortBy sorts the list stably by given orders, the later order is used only if the former orders are equal.
+// e.g. countries.SortBy(CountryByName(language), CountryByCode.Reverse())
+func (c Countries) SortBy(orders ...CountryCompareFunc) {
+	var byOrders CountryLessFunc = func(c1, c2 *Country) bool {
+		for _, order := range orders {
+			if r := order(c1, c2); r != 0 {
+				return r < 0
+			}
+		}
+		return false
 	}
 
-	byCode.Sort(c)
+	byOrders.Sort(c)
 }
 
-// SortByName sorts the list by name.
-func (c Countries) SortByName(language *Language) {
-	var byName CountryLessFunc = func(c1, c2 *Country) bool {
-		name1 := c1.Name.Value(language)
-		name2 := c2.Name.Value(language)
-		return name1 < name2
+// CountryCompareFunc represents the compare function for ordering countries.
+// It returns -1 if c1 < c2, 0 if c1 == c2, +1 if c1 > c2 (nil sorts first).
+type CountryCompareFunc func(c1, c2 *Country) int
+
+// Reverse returns the compare function of reverse order.
+func (ccf CountryCompareFunc) Reverse() CountryCompareFunc {
+	return func(c1, c2 *Country) int {
+		return ccf(c2, c1)
 	}
+}
+
+// CountryByCode compares countries by alpha-2 code.
+var CountryByCode CountryCompareFunc = func(c1, c2 *Country) int {
+	if r, ok := compareNil(c1 == nil, c2 == nil); ok {
+		return r
+	}
+	return compareString(c1.Alpha2Code, c2.Alpha2Code)
+}
+
+// CountryByName returns the compare function which compares countries by name with the collation of language.
+func CountryByName(language *Language) CountryCompareFunc {
+	return countryByName(func(name *MultiLanguageString) string {
+		return name.Value(language)
+	}, newLanguageCollator(language))
+}
+
+// CountryByNameWithCulture returns the compare function which compares countries by name of culture (falls back to the name in its language) with the collation of culture.
+func CountryByNameWithCulture(culture *Culture) CountryCompareFunc {
+	return countryByName(func(name *MultiLanguageString) string {
+		return name.CultureValue(culture)
+	}, NewCollator(culture))
+}
 
-	byName.Sort(c)
+func countryByName(value func(name *MultiLanguageString) string, collator *Collator) CountryCompareFunc {
+	return func(c1, c2 *Country) int {
+		if r, ok := compareNil(c1 == nil, c2 == nil); ok {
+			return r
+		}
+		return collator.Compare(value(c1.Name), value(c2.Name))
+	}
 }
 
 // CountryLessFunc represents the less function for sorting countries.
 type CountryLessFunc func(c1, c2 *Country) bool
 
-// Sort is a method on the function type that sorts the argument slic according to the function.
+// Sort is a method on the function type that sorts the argument slic stably according to the function.
 func (clf CountryLessFunc) Sort(list Countries) {
 	sorter := &countrySorter{
 		List:     list,
 		LessFunc: clf, // The sort method's receiver is the function (closure) that defines the sort order.
 	}
 
-	sort.Sort(sorter)
+	sort.Stable(sorter)
 }
 
 // countrySorter joins a CountryLessFunc function and Countries to be sorted.
@@ -81,6 +165,7 @@ var (
 	countryTableAlpha2  map[string]*Country
 	countryTableAlpha3  map[string]*Country
 	countryTableNumeric map[string]*Country
+	countryTableCalling map[string]Countries // key: calling code without plus sign
 	countryList         Countries
 )
 
@@ -88,29 +173,43 @@ func init() {
 	countryTableAlpha2 = make(map[string]*Country)
 	countryTableAlpha3 = make(map[string]*Country)
 	countryTableNumeric = make(map[string]*Country)
+	countryTableCalling = make(map[string]Countries)
 	countryList = make(Countries, len(countryCodes))
 	for i, codes := range countryCodes {
 		alpha2Code := codes[0]
 		alpha3Code := codes[1]
 		numericCode := codes[2]
+		tld, ok := countryTLDs[alpha2Code]
+		if !ok {
+			tld = "." + strings.ToLower(alpha2Code)
+		}
 		country := &Country{
-			Alpha2Code:  alpha2Code,
-			Alpha3Code:  alpha3Code,
-			NumericCode: numericCode,
-			Name:        NewMultiLanguageString(),
-			Aliases:     NewMultiLanguageStringArray(";"),
+			Alpha2Code:   alpha2Code,
+			Alpha3Code:   alpha3Code,
+			NumericCode:  numericCode,
+			Name:         NewMultiLanguageString(),
+			Aliases:      NewMultiLanguageStringArray(";"),
+			CallingCodes: countryCallingCodes[alpha2Code],
+			TLD:          tld,
 		}
 
 		countryTableAlpha2[alpha2Code] = country
 		countryTableAlpha3[alpha3Code] = country
 		countryTableNumeric[numericCode] = country
+		for _, callingCode := range country.CallingCodes {
+			callingCode = strings.TrimPrefix(callingCode, "+")
+			countryTableCalling[callingCode] = append(countryTableCalling[callingCode], country)
+		}
 		countryList[i] = country
 	}
 }
 
 // AllCountries returns the list of all countries.
+// The list is a copy, it's safe to sort or modify the list (but not the countries in it).
 func AllCountries() Countries {
-	return countryList
+	list := make(Countries, len(countryList))
+	copy(list, countryList)
+	return list
 }
 
 // LookupCountry returns the country by given keyword (code, name, alias with language).
@@ -134,20 +233,30 @@ func LookupCountry(language *Language, keyword string) (*Country, bool) {
 			return v, true
 		}
 	}
-	// keyword
+	// keyword (case & diacritic insensitive)
 	if language != nil {
-		for _, country := range countryList {
-			// compare name
-			name := country.Name.Value(language)
-			if strings.EqualFold(name, keyword) {
-				return country, true
-			}
+		if key := foldKey(keyword); len(key) > 0 {
+			v, ok := countryNameIndexOf(language).countries[key]
+			return v, ok
+		}
+	}
+	return nil, false
+}
 
-			// compare aliases
-			aliases := country.Aliases.Value(language)
-			if strings.Contains(strings.ToLower(aliases), strings.ToLower(keyword)) {
-				return country, true
-			}
+// LookupCountryByCallingCode returns the countries by given international calling code (e.g. +44, 0044).
+// More than one country may share the same calling code (e.g. +1).
+func LookupCountryByCallingCode(code string) (Countries, bool) {
+	code = strings.TrimSpace(code)
+	if strings.HasPrefix(code, "+") {
+		code = code[1:]
+	} else if strings.HasPrefix(code, "00") {
+		code = code[2:]
+	}
+	if len(code) > 0 {
+		if list, ok := countryTableCalling[code]; ok {
+			countries := make(Countries, len(list))
+			copy(countries, list)
+			return countries, true
 		}
 	}
 	return nil, false
@@ -0,0 +1,268 @@
+package i18n
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unicode"
+)
+
+// CountrySearchResult represents a result of country searching.
+type CountrySearchResult struct {
+	Country *Country
+	Score   float64 // score of matching (0~1, 1 means exact match)
+}
+
+// Scores of country searching.
+const (
+	countrySearchScoreExact       = 1
+	countrySearchScoreAlias       = 0.95
+	countrySearchScorePrefix      = 0.9
+	countrySearchScoreAliasPrefix = 0.85
+	countrySearchScoreToken       = 0.8
+	countrySearchScoreAliasToken  = 0.75
+	countrySearchScoreFuzzy       = 0.7
+)
+
+// SearchCountries searches the countries by given query (code, name or alias with language) and returns the results ordered by score.
+// The matching is case & diacritic insensitive (e.g. "cote d'ivoire" matches "Côte d’Ivoire"),
+// and prefixes of name (for autocomplete) or names with typo are matched too.
+// It returns all matched results if limit <= 0.
+func SearchCountries(language *Language, query string, limit int) []*CountrySearchResult {
+	tokens := foldTokens(query)
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	var results []*CountrySearchResult
+	for _, country := range countryList {
+		score := matchCountry(country, language, query, tokens)
+		if score > 0 {
+			results = append(results, &CountrySearchResult{
+				Country: country,
+				Score:   score,
+			})
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		return results[i].Country.Alpha2Code < results[j].Country.Alpha2Code
+	})
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results
+}
+
+// matchCountry returns the score of country with given query.
+func matchCountry(country *Country, language *Language, query string, tokens []string) float64 {
+	query = strings.TrimSpace(query)
+	if strings.EqualFold(query, country.Alpha2Code) || strings.EqualFold(query, country.Alpha3Code) || query == country.NumericCode {
+		return countrySearchScoreExact
+	}
+	if language == nil {
+		return 0
+	}
+
+	score := matchName(country.Name.Value(language), tokens, countrySearchScoreExact, countrySearchScorePrefix, countrySearchScoreToken)
+	for _, alias := range country.Aliases.Values(language) {
+		if s := matchName(alias, tokens, countrySearchScoreAlias, countrySearchScoreAliasPrefix, countrySearchScoreAliasToken); s > score {
+			score = s
+		}
+	}
+	return score
+}
+
+// matchName returns the score of name with given query tokens.
+func matchName(name string, tokens []string, exact, prefix, token float64) float64 {
+	nameTokens := foldTokens(name)
+	if len(nameTokens) == 0 {
+		return 0
+	}
+
+	n := strings.Join(nameTokens, " ")
+	q := strings.Join(tokens, " ")
+	switch {
+	case n == q:
+		return exact
+	case strings.HasPrefix(n, q):
+		return prefix
+	}
+
+	// every query token is the prefix of a name token
+	matched := true
+	for _, t := range tokens {
+		found := false
+		for _, nt := range nameTokens {
+			if strings.HasPrefix(nt, t) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			matched = false
+			break
+		}
+	}
+	if matched {
+		return token
+	}
+
+	// typo
+	if len(q) >= 4 {
+		distance := levenshteinDistance(n, q)
+		if distance <= len(q)/4 {
+			return countrySearchScoreFuzzy * (1 - float64(distance)/float64(len(q)))
+		}
+	}
+
+	return 0
+}
+
+// countryNameIndex represents the index of folded names & aliases of countries in a language.
+type countryNameIndex struct {
+	generation uint64              // generation of multi-language strings when the index was built
+	countries  map[string]*Country // key: folded name or alias
+}
+
+var (
+	countryNameIndexMutex sync.RWMutex
+	countryNameIndexes    = make(map[string]*countryNameIndex) // key: lower-cased language code
+)
+
+// countryNameIndexOf returns the index of country names in the language.
+// The index is built on first use and rebuilt after any name is changed.
+func countryNameIndexOf(language *Language) *countryNameIndex {
+	code := strings.ToLower(language.Code)
+	generation := atomic.LoadUint64(&multiLanguageGeneration)
+
+	countryNameIndexMutex.RLock()
+	index, ok := countryNameIndexes[code]
+	countryNameIndexMutex.RUnlock()
+	if ok && index.generation == generation {
+		return index
+	}
+
+	index = &countryNameIndex{
+		generation: generation,
+		countries:  make(map[string]*Country),
+	}
+	for _, country := range countryList {
+		// the first country wins if countries share a name or alias (the name goes before aliases)
+		keys := []string{foldKey(country.Name.Value(language))}
+		for _, alias := range country.Aliases.Values(language) {
+			keys = append(keys, foldKey(alias))
+		}
+		for _, key := range keys {
+			if _, ok := index.countries[key]; !ok && len(key) > 0 {
+				index.countries[key] = country
+			}
+		}
+	}
+
+	countryNameIndexMutex.Lock()
+	countryNameIndexes[code] = index
+	countryNameIndexMutex.Unlock()
+	return index
+}
+
+// foldKey returns the folded tokens of the string joined by space.
+func foldKey(s string) string {
+	return strings.Join(foldTokens(s), " ")
+}
+
+// foldTokens returns the lower-cased & diacritic removed tokens of the string.
+func foldTokens(s string) []string {
+	return strings.FieldsFunc(foldString(s), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// foldString returns the lower-cased & diacritic removed string.
+// The combining marks (e.g. in decomposed strings like "o" + U+0302) are removed too, so they are not token separators.
+func foldString(s string) string {
+	var buf strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if v, ok := foldTable[r]; ok {
+			buf.WriteString(v)
+			continue
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
+// levenshteinDistance returns the edit distance between two strings.
+func levenshteinDistance(s1, s2 string) int {
+	r1, r2 := []rune(s1), []rune(s2)
+	row := make([]int, len(r2)+1)
+	for j := range row {
+		row[j] = j
+	}
+	for i := 1; i <= len(r1); i++ {
+		prev := row[0]
+		row[0] = i
+		for j := 1; j <= len(r2); j++ {
+			current := row[j]
+			cost := 1
+			if r1[i-1] == r2[j-1] {
+				cost = 0
+			}
+			row[j] = minInt(minInt(row[j]+1, row[j-1]+1), prev+cost)
+			prev = current
+		}
+	}
+	return row[len(r2)]
+}
+
+func minInt(x, y int) int {
+	if x < y {
+		return x
+	}
+	return y
+}
+
+// foldTable maps the (lower-cased) latin letters with diacritic to the basic latin letters.
+var foldTable = newFoldTable()
+
+func newFoldTable() map[rune]string {
+	table := make(map[rune]string)
+	for base, letters := range map[string]string{
+		"a":  "àáâãäåāăąǎ",
+		"ae": "æ",
+		"c":  "çćĉċč",
+		"d":  "ďđð",
+		"e":  "èéêëēĕėęě",
+		"g":  "ĝğġģ",
+		"h":  "ĥħ",
+		"i":  "ìíîïĩīĭįı",
+		"ij": "ĳ",
+		"j":  "ĵ",
+		"k":  "ķ",
+		"l":  "ĺļľŀł",
+		"n":  "ñńņňŉ",
+		"o":  "òóôõöøōŏőǒ",
+		"oe": "œ",
+		"r":  "ŕŗř",
+		"s":  "śŝşšș",
+		"ss": "ß",
+		"t":  "ţťŧț",
+		"th": "þ",
+		"u":  "ùúûüũūŭůűųǔ",
+		"w":  "ŵ",
+		"y":  "ýÿŷ",
+		"z":  "źżž",
+	} {
+		for _, r := range letters {
+			table[r] = base
+		}
+	}
+	return table
+}
@@ -0,0 +1,117 @@
+package i18n
+
+import (
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestCountry(t *testing.T) {
+	gb, _ := LookupCountry(nil, "GB")
+	testing2.AssertEqual(t, gb.CallingCodes, []string{"+44"})
+	testing2.AssertEqual(t, gb.TLD, ".uk")
+	testing2.AssertEqual(t, gb.Flag(), "🇬🇧")
+	de, _ := LookupCountry(nil, "DEU")
+	testing2.AssertEqual(t, de.TLD, ".de")
+	testing2.AssertEqual(t, de.Flag(), "🇩🇪")
+
+	// test LookupCountryByCallingCode
+	countries, ok := LookupCountryByCallingCode("+44")
+	testing2.AssertEqual(t, ok, true)
+	countries.SortByCode()
+	codes := make([]string, len(countries))
+	for i, country := range countries {
+		codes[i] = country.Alpha2Code
+	}
+	testing2.AssertEqual(t, codes, []string{"GB", "GG", "IM", "JE"})
+	countries, ok = LookupCountryByCallingCode("001")
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, len(countries) > 2, true)
+	countries, _ = LookupCountryByCallingCode("+379")
+	testing2.AssertEqual(t, countries[0].Alpha2Code, "VA")
+	_, ok = LookupCountryByCallingCode("+999")
+	testing2.AssertEqual(t, ok, false)
+}
+
+func TestSearchCountries(t *testing.T) {
+	english, _ := LookupLanguage("en")
+	names := map[string]string{
+		"CI": "Côte d’Ivoire",
+		"IN": "India",
+		"ID": "Indonesia",
+		"DE": "Germany",
+		"GB": "United Kingdom",
+		"US": "United States of America",
+	}
+	for code, name := range names {
+		country, _ := LookupCountry(nil, code)
+		country.Name.SetValue(english, name)
+	}
+	gb, _ := LookupCountry(nil, "GB")
+	gb.Aliases.SetValues(english, []string{"UK", "Great Britain"})
+	defer func() {
+		for code := range names {
+			country, _ := LookupCountry(nil, code)
+			country.Name.SetValue(english, "")
+		}
+		gb.Aliases.SetValues(english, nil)
+	}()
+
+	// test LookupCountry
+	ci, ok := LookupCountry(english, "cote d'ivoire")
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, ci.Alpha2Code, "CI")
+	ci, ok = LookupCountry(english, "Co\u0302te d\u2019Ivoire") // decomposed (NFD)
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, ci.Alpha2Code, "CI")
+	country, ok := LookupCountry(english, "great britain")
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, country.Alpha2Code, "GB")
+	_, ok = LookupCountry(english, "in britain") // no substring matching
+	testing2.AssertEqual(t, ok, false)
+	gb.Aliases.SetValues(english, []string{"UK", "Britain"}) // the index of names is rebuilt
+	_, ok = LookupCountry(english, "great britain")
+	testing2.AssertEqual(t, ok, false)
+	country, ok = LookupCountry(english, "BRITAIN")
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, country.Alpha2Code, "GB")
+
+	// test SearchCountries
+	results := SearchCountries(english, "ind", 10)
+	testing2.AssertEqual(t, len(results), 2) // India (alpha-3 code IND), Indonesia
+	testing2.AssertEqual(t, results[0].Country.Alpha2Code, "IN")
+	testing2.AssertEqual(t, results[0].Score, 1.0)
+	testing2.AssertEqual(t, results[1].Country.Alpha2Code, "ID")
+	results = SearchCountries(english, "united", 1)
+	testing2.AssertEqual(t, len(results), 1)
+	testing2.AssertEqual(t, results[0].Country.Alpha2Code, "GB")
+	results = SearchCountries(english, "kingdom", 0)
+	testing2.AssertEqual(t, results[0].Country.Alpha2Code, "GB")
+	testing2.AssertEqual(t, results[0].Score, countrySearchScoreToken)
+	results = SearchCountries(english, "Cote d Ivoire", 0)
+	testing2.AssertEqual(t, results[0].Country.Alpha2Code, "CI")
+	testing2.AssertEqual(t, results[0].Score, 1.0)
+	results = SearchCountries(english, "Co\u0302te d'Ivoire", 0) // decomposed (NFD)
+	testing2.AssertEqual(t, results[0].Country.Alpha2Code, "CI")
+	testing2.AssertEqual(t, results[0].Score, 1.0)
+	results = SearchCountries(english, "Germny", 0)
+	testing2.AssertEqual(t, results[0].Country.Alpha2Code, "DE")
+	testing2.AssertEqual(t, results[0].Score < countrySearchScoreFuzzy, true)
+	results = SearchCountries(english, "uk", 0)
+	testing2.AssertEqual(t, results[0].Country.Alpha2Code, "GB")
+	testing2.AssertEqual(t, len(SearchCountries(english, " ", 0)), 0)
+}
+
+func TestAllCountries(t *testing.T) {
+	list := AllCountries()
+	first := list[0]
+	list.SortBy(CountryByCode.Reverse())
+	testing2.AssertEqual(t, AllCountries()[0], first)
+	list[0] = nil
+	testing2.AssertEqual(t, AllCountries()[0], first)
+
+	cultures := AllCultures()
+	cultures.SortByCode()
+	testing2.AssertEqual(t, cultures[0].Code, "af-ZA")
+	testing2.AssertEqual(t, AllCultures()[0] == cultureList[0], true)
+}
@@ -7,10 +7,11 @@ import (
 
 // Culture represents a culture. Based on RFC4646
 type Culture struct {
-	Code       string // code of culture in the xx-YY format(xx:language, YY:country), e.g. en-US
+	Code       string // code of culture in the xx-YY format(xx:language, YY:country or UN M.49 region), e.g. en-US, es-419
 	NativeName string // native name
 	Name       *MultiLanguageString
-	Country    *Country
+	Country    *Country // nil if the culture is region specific (e.g. es-419)
+	Region     *Region  // region of culture (the region of country if the culture is country specific)
 	Language   *Language
 	Currency   *Currency
 	Formatter  *Formatter
@@ -37,89 +38,158 @@ type Cultures []*Culture
 
 // SortByCode sorts the list by code.
 func (c Cultures) SortByCode() {
-	var byCode CultureLessFunc = func(c1, c2 *Culture) bool {
-		return c1.Code < c1.Code
-	}
-
-	byCode.Sort(c)
+	c.SortBy(CultureByCode)
 }
 
-// SortByName sorts the list by name.
+// SortByName sorts the list by name with the collation of language.
 func (c Cultures) SortByName(language *Language) {
-	var byName CultureLessFunc = func(c1, c2 *Culture) bool {
-		name1 := c1.Name.Value(language)
-		name2 := c2.Name.Value(language)
-		return name1 < name2
-	}
+	c.sortByName(func(name *MultiLanguageString) string {
+		return name.Value(language)
+	}, newLanguageCollator(language))
+}
 
-	byName.Sort(c)
+// SortByNameWithCulture sorts the list by name of culture (falls back to the name in its language) with the collation of culture.
+func (c Cultures) SortByNameWithCulture(culture *Culture) {
+	c.sortByName(func(name *MultiLanguageString) string {
+		return name.CultureValue(culture)
+	}, NewCollator(culture))
+}
+
+// sortByName sorts the list stably by name, the sort keys of names are computed once.
+func (c Cultures) sortByName(value func(name *MultiLanguageString) string, collator *Collator) {
+	collator.sortByKeys(len(c), func(i int) (string, bool) {
+		if c[i] == nil {
+			return "", false
+		}
+		return value(c[i].Name), true
+	}, func(i, j int) {
+		c[i], c[j] = c[j], c[i]
+	})
 }
 
 // SortByCountryCode sorts the cultures by country code.
 func (c Cultures) SortByCountryCode() {
-	var byCountry CultureLessFunc = func(c1, c2 *Culture) bool {
-		if c1.Country == nil {
-			return true
-		}
+	c.SortBy(CultureByCountryCode)
+}
 
-		if c2.Country == nil {
-			return false
-		}
+// SortByCountryName sorts the cultures by country name with the collation of language.
+func (c Cultures) SortByCountryName(language *Language) {
+	c.SortBy(CultureByCountryName(language))
+}
 
-		return c1.Country.Alpha2Code < c2.Country.Alpha2Code
-	}
+// SortByCountryNameWithCulture sorts the cultures by country name of culture (falls back to the name in its language) with the collation of culture.
+func (c Cultures) SortByCountryNameWithCulture(culture *Culture) {
+	c.SortBy(CultureByCountryNameWithCulture(culture))
+}
 
-	byCountry.Sort(c)
+// SortByLanguageCode sorts the cultures by language code.
+func (c Cultures) SortByLanguageCode() {
+	c.SortBy(CultureByLanguageCode)
 }
 
-// SortByCountryName sorts the cultures by country name.
-func (c Cultures) SortByCountryName(language *Language) {
-	var byCountry CultureLessFunc = func(c1, c2 *Culture) bool {
-		if c1.Country == nil {
-			return true
+// SortBy sorts the list stably by given orders, the later order is used only if the former orders are equal.
+// e.g. cultures.SortBy(CultureByLanguageCode, CultureByCountryName(language))
+func (c Cultures) SortBy(orders ...CultureCompareFunc) {
+	var byOrders CultureLessFunc = func(c1, c2 *Culture) bool {
+		for _, order := range orders {
+			if r := order(c1, c2); r != 0 {
+				return r < 0
+			}
 		}
+		return false
+	}
 
-		if c2.Country == nil {
-			return false
-		}
+	byOrders.Sort(c)
+}
 
-		name1 := c1.Country.Name.Value(language)
-		name2 := c2.Country.Name.Value(language)
+// CultureCompareFunc represents the compare function for ordering cultures.
+// It returns -1 if c1 < c2, 0 if c1 == c2, +1 if c1 > c2 (nil sorts first).
+type CultureCompareFunc func(c1, c2 *Culture) int
 
-		return name1 < name2
+// Reverse returns the compare function of reverse order.
+func (ccf CultureCompareFunc) Reverse() CultureCompareFunc {
+	return func(c1, c2 *Culture) int {
+		return ccf(c2, c1)
 	}
+}
 
-	byCountry.Sort(c)
+// CultureByCode compares cultures by code.
+var CultureByCode CultureCompareFunc = func(c1, c2 *Culture) int {
+	if r, ok := compareNil(c1 == nil, c2 == nil); ok {
+		return r
+	}
+	return compareString(c1.Code, c2.Code)
 }
 
-// SortByLanguageCode sorts the cultures by language code.
-func (c Cultures) SortByLanguageCode() {
-	var byLanguage CultureLessFunc = func(c1, c2 *Culture) bool {
-		if c1.Language == nil {
-			return true
-		}
+// CultureByName returns the compare function which compares cultures by name with the collation of language.
+func CultureByName(language *Language) CultureCompareFunc {
+	return cultureByName(func(name *MultiLanguageString) string {
+		return name.Value(language)
+	}, newLanguageCollator(language))
+}
 
-		if c2.Language == nil {
-			return false
+// CultureByNameWithCulture returns the compare function which compares cultures by name of culture (falls back to the name in its language) with the collation of culture.
+func CultureByNameWithCulture(culture *Culture) CultureCompareFunc {
+	return cultureByName(func(name *MultiLanguageString) string {
+		return name.CultureValue(culture)
+	}, NewCollator(culture))
+}
+
+func cultureByName(value func(name *MultiLanguageString) string, collator *Collator) CultureCompareFunc {
+	return func(c1, c2 *Culture) int {
+		if r, ok := compareNil(c1 == nil, c2 == nil); ok {
+			return r
 		}
+		return collator.Compare(value(c1.Name), value(c2.Name))
+	}
+}
+
+// CultureByCountryCode compares cultures by country code (the culture without country sorts first).
+var CultureByCountryCode CultureCompareFunc = func(c1, c2 *Culture) int {
+	if r, ok := compareNil(c1 == nil, c2 == nil); ok {
+		return r
+	}
+	return CountryByCode(c1.Country, c2.Country)
+}
+
+// CultureByCountryName returns the compare function which compares cultures by country name with the collation of language.
+func CultureByCountryName(language *Language) CultureCompareFunc {
+	return cultureByCountry(CountryByName(language))
+}
+
+// CultureByCountryNameWithCulture returns the compare function which compares cultures by country name of culture (falls back to the name in its language) with the collation of culture.
+func CultureByCountryNameWithCulture(culture *Culture) CultureCompareFunc {
+	return cultureByCountry(CountryByNameWithCulture(culture))
+}
 
-		return c1.Language.Code < c2.Language.Code
+func cultureByCountry(order CountryCompareFunc) CultureCompareFunc {
+	return func(c1, c2 *Culture) int {
+		if r, ok := compareNil(c1 == nil, c2 == nil); ok {
+			return r
+		}
+		return order(c1.Country, c2.Country)
 	}
+}
 
-	byLanguage.Sort(c)
+// CultureByLanguageCode compares cultures by language code.
+var CultureByLanguageCode CultureCompareFunc = func(c1, c2 *Culture) int {
+	if r, ok := compareNil(c1 == nil, c2 == nil); ok {
+		return r
+	}
+	return LanguageByCode(c1.Language, c2.Language)
 }
 
 // CultureLessFunc represents the less function for sorting cultures.
 type CultureLessFunc func(c1, c2 *Culture) bool
 
-// Sort is a method on the function type that sorts the argument slic according to the function.
+// Sort is a method on the function type that sorts the argument slic stably according to the function.
 func (clf CultureLessFunc) Sort(list Cultures) {
 	sorter := &cultureSorter{
 		List:     list,
 		LessFunc: clf, // The sort method's receiver is the function (closure) that defines the sort order.
 	}
 
-	sort.Sort(sorter)
+	sort.Stable(sorter)
 }
 
 // cultureSorter joins a CultureLessFunc function and a slic of Culture to be sorted.
@@ -154,21 +224,13 @@ func init() {
 	for i, code := range cultureCodes {
 		nativeName := cultureNativeNames[code]
 		countryCode := code[strings.LastIndex(code, "-")+1:]
-		languageCode := code[0:strings.Index(code, "-")]
 		country, _ := LookupCountry(nil, countryCode)
-		language, _ := LookupLanguage(languageCode)
-		var curr *Currency
-		if currencyCode, ok := cultureCurrencyCodes[code]; ok {
-			curr, _ = LookupCurrency(currencyCode)
-		}
 		formatter := cultureFormatters[code]
-		culture := &Culture{
+		culture := &Culture{ // language & currency are set by the init functions of language & currency
 			Code:       code,
 			NativeName: nativeName,
 			Name:       NewMultiLanguageString(),
 			Country:    country,
-			Language:   language,
-			Currency:   curr,
 			Formatter:  formatter,
 		}
 		cultureTable[strings.ToLower(code)] = culture
@@ -177,8 +239,11 @@ func init() {
 }
 
 // AllCultures returns the list of all cultures.
+// The list is a copy, it's safe to sort or modify the list (but not the cultures in it).
 func AllCultures() Cultures {
-	return cultureList
+	list := make(Cultures, len(cultureList))
+	copy(list, cultureList)
+	return list
 }
 
 // LookupCulture returns the culture by given code.
@@ -62,6 +62,7 @@ var cultureCodes = []string{
 	"en-US",
 	"en-ZA",
 	"en-ZW",
+	"es-419",
 	"es-AR",
 	"es-BO",
 	"es-CL",
@@ -1141,6 +1141,25 @@ var cultureFormatters = map[string]*Formatter{
 			GroupSeparator:   ",",
 		},
 	},
+	"es-419": &Formatter{
+		Number: &NumberFormatter{
+			PositivePattern:  "n",
+			NegativePattern:  "-n",
+			DecimalDigits:    2,
+			DecimalSeparator: ".",
+			GroupSizes:       []int{3},
+			GroupSeparator:   ",",
+		},
+		Currency: &CurrencyFormatter{
+			Symbol:           "$",
+			PositivePattern:  "$n",
+			NegativePattern:  "-$n",
+			DecimalDigits:    2,
+			DecimalSeparator: ".",
+			GroupSizes:       []int{3},
+			GroupSeparator:   ",",
+		},
+	},
 	"es-AR": &Formatter{
 		Number: &NumberFormatter{
 			PositivePattern:  "n",
@@ -62,6 +62,7 @@ var cultureNativeNames = map[string]string{
 	"en-US":       "English (United States)",
 	"en-ZA":       "English (South Africa)",
 	"en-ZW":       "English (Zimbabwe)",
+	"es-419":      "Español (Latinoamérica)",
 	"es-AR":       "Español (Argentina)",
 	"es-BO":       "Español (Bolivia)",
 	"es-CL":       "Español (Chile)",
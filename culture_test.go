@@ -0,0 +1,53 @@
+package i18n
+
+import (
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestCulturesSortBy(t *testing.T) {
+	codes := func(list Cultures) []string {
+		values := make([]string, len(list))
+		for i, culture := range list {
+			values[i] = culture.Code
+		}
+		return values
+	}
+	lookup := func(codes ...string) Cultures {
+		list := make(Cultures, len(codes))
+		for i, code := range codes {
+			list[i], _ = LookupCulture(code)
+		}
+		return list
+	}
+
+	list := lookup("fr-CA", "en-US", "es-419", "en-CA", "fr-FR", "es-MX")
+	list.SortByCode()
+	testing2.AssertEqual(t, codes(list), []string{"en-CA", "en-US", "es-419", "es-MX", "fr-CA", "fr-FR"})
+
+	// multiple keys & reverse order
+	list.SortBy(CultureByCountryCode, CultureByLanguageCode.Reverse())
+	testing2.AssertEqual(t, codes(list), []string{"es-419", "fr-CA", "en-CA", "fr-FR", "es-MX", "en-US"})
+
+	// stable
+	list = lookup("fr-FR", "en-US", "fr-CA", "en-CA")
+	list.SortByLanguageCode()
+	testing2.AssertEqual(t, codes(list), []string{"en-US", "en-CA", "fr-FR", "fr-CA"})
+
+	// nil-safe
+	list = append(list, nil)
+	list.SortBy(CultureByCode.Reverse())
+	testing2.AssertEqual(t, list[len(list)-1] == nil, true)
+	list.SortBy(CultureByCountryCode)
+	testing2.AssertEqual(t, list[0] == nil, true)
+
+	languages := Languages{nil}
+	for _, code := range []string{"fr", "en", "de"} {
+		language, _ := LookupLanguage(code)
+		languages = append(languages, language)
+	}
+	languages.SortBy(LanguageByCode.Reverse())
+	testing2.AssertEqual(t, languages[0].Code, "fr")
+	testing2.AssertEqual(t, languages[3] == nil, true)
+}
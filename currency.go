@@ -7,8 +7,11 @@ import (
 
 // Currency represents a currency. ISO 4217
 type Currency struct {
-	Code string // ISO Alpha-3 Currency Code
-	Name *MultiLanguageString
+	Code         string // ISO Alpha-3 Currency Code
+	Name         *MultiLanguageString
+	PluralName   *MultiLanguageString // plural form of name, e.g. US dollars (the name is used if not set)
+	MinorUnit    int                  // number of decimal digits (ISO 4217), e.g. 2 for USD, 0 for JPY, -1 if not applicable (e.g. XAU)
+	CashRounding float64              // rounding increment of cash payment, e.g. 0.05 for CHF (0 if cash is rounded to the minor unit)
 }
 
 // Equal reports whether two currencies are same.
@@ -22,23 +25,89 @@ type Currencies []*Currency
 
 // SortByCode sorts the list by code.
 func (c Currencies) SortByCode() {
-	var byCode CurrencyLessFunc = func(c1, c2 *Currency) bool {
-		return c1.Code < c2.Code
+	c.SortBy(CurrencyByCode)
+}
+
+// SortByName sorts the list by name with the collation of language.
+func (c Currencies) SortByName(language *Language) {
+	c.sortByName(func(name *MultiLanguageString) string {
+		return name.Value(language)
+	}, newLanguageCollator(language))
+}
+
+// SortByNameWithCulture sorts the list by name of culture (falls back to the name in its language) with the collation of culture.
+func (c Currencies) SortByNameWithCulture(culture *Culture) {
+	c.sortByName(func(name *MultiLanguageString) string {
+		return name.CultureValue(culture)
+	}, NewCollator(culture))
+}
+
+// sortByName sorts the list stably by name, the sort keys of names are computed once.
+func (c Currencies) sortByName(value func(name *MultiLanguageString) string, collator *Collator) {
+	collator.sortByKeys(len(c), func(i int) (string, bool) {
+		if c[i] == nil {
+			return "", false
+		}
+		return value(c[i].Name), true
+	}, func(i, j int) {
+		c[i], c[j] = c[j], c[i]
+	})
+}
+
+// SortBy sorts the list stably by given orders, the later order is used only if the former orders are equal.
+func (c Currencies) SortBy(orders ...CurrencyCompareFunc) {
+	var byOrders CurrencyLessFunc = func(c1, c2 *Currency) bool {
+		for _, order := range orders {
+			if r := order(c1, c2); r != 0 {
+				return r < 0
+			}
+		}
+		return false
 	}
 
-	byCode.Sort(c)
+	byOrders.Sort(c)
 }
 
-// SortByName sorts the list by name.
-func (c Currencies) SortByName(language *Language) {
-	var byName CurrencyLessFunc = func(c1, c2 *Currency) bool {
-		name1 := c1.Name.Value(language)
-		name2 := c2.Name.Value(language)
+// CurrencyCompareFunc represents the compare function for ordering currencies.
+// It returns -1 if c1 < c2, 0 if c1 == c2, +1 if c1 > c2 (nil sorts first).
+type CurrencyCompareFunc func(c1, c2 *Currency) int
+
+// Reverse returns the compare function of reverse order.
+func (ccf CurrencyCompareFunc) Reverse() CurrencyCompareFunc {
+	return func(c1, c2 *Currency) int {
+		return ccf(c2, c1)
+	}
+}
 
-		return name1 < name2
+// CurrencyByCode compares currencies by code.
+var CurrencyByCode CurrencyCompareFunc = func(c1, c2 *Currency) int {
+	if r, ok := compareNil(c1 == nil, c2 == nil); ok {
+		return r
 	}
+	return compareString(c1.Code, c2.Code)
+}
 
-	byName.Sort(c)
+// CurrencyByName returns the compare function which compares currencies by name with the collation of language.
+func CurrencyByName(language *Language) CurrencyCompareFunc {
+	return currencyByName(func(name *MultiLanguageString) string {
+		return name.Value(language)
+	}, newLanguageCollator(language))
+}
+
+// CurrencyByNameWithCulture returns the compare function which compares currencies by name of culture (falls back to the name in its language) with the collation of culture.
+func CurrencyByNameWithCulture(culture *Culture) CurrencyCompareFunc {
+	return currencyByName(func(name *MultiLanguageString) string {
+		return name.CultureValue(culture)
+	}, NewCollator(culture))
+}
+
+func currencyByName(value func(name *MultiLanguageString) string, collator *Collator) CurrencyCompareFunc {
+	return func(c1, c2 *Currency) int {
+		if r, ok := compareNil(c1 == nil, c2 == nil); ok {
+			return r
+		}
+		return collator.Compare(value(c1.Name), value(c2.Name))
+	}
 }
 
 // currencySorter joins a CurrencyLessFunc function and Currencies to be sorted.
@@ -65,14 +134,14 @@ func (cs currencySorter) Less(i, j int) bool {
 // CurrencyLessFunc represents the less function for sorting currencies.
 type CurrencyLessFunc func(c1, c2 *Currency) bool
 
-// Sort is a method on the function type that sorts the argument slic according to the function.
+// Sort is a method on the function type that sorts the argument slic stably according to the function.
 func (clf CurrencyLessFunc) Sort(list Currencies) {
 	sorter := &currencySorter{
 		List:     list,
 		LessFunc: clf, // The sort method's receiver is the function (closure) that defines the sort order.
 	}
 
-	sort.Sort(sorter)
+	sort.Stable(sorter)
 }
 
 var (
@@ -85,18 +154,35 @@ func init() {
 	currencyList = make(Currencies, len(currencyCodes))
 	for i, v := range currencyCodes {
 		currency := &Currency{
-			Code: v,
-			Name: NewMultiLanguageString(),
+			Code:       v,
+			Name:       NewMultiLanguageString(),
+			PluralName: NewMultiLanguageString(),
+			MinorUnit:  2,
+		}
+		if minorUnit, ok := currencyMinorUnits[v]; ok {
+			currency.MinorUnit = minorUnit
+		}
+		if increment, ok := currencyCashRoundings[v]; ok {
+			currency.CashRounding = increment
 		}
 
 		currencyTable[v] = currency
 		currencyList[i] = currency
 	}
+	// cultures
+	for _, culture := range cultureList {
+		if code, ok := cultureCurrencyCodes[culture.Code]; ok {
+			culture.Currency = currencyTable[code]
+		}
+	}
 }
 
 // AllCurrencies returns the list of all currencies.
+// The list is a copy, it's safe to sort or modify the list (but not the currencies in it).
 func AllCurrencies() Currencies {
-	return currencyList
+	list := make(Currencies, len(currencyList))
+	copy(list, currencyList)
+	return list
 }
 
 // LookupCurrency returns the currency by given code.
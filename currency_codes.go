@@ -181,3 +181,68 @@ var currencyCodes = []string{
 	"ZMW",
 	"ZWL",
 }
+
+// Minor units (number of decimal digits) of currencies which is not 2, ISO 4217 (-1 means not applicable).
+var currencyMinorUnits = map[string]int{
+	"BHD": 3,
+	"BIF": 0,
+	"BYR": 0,
+	"CLF": 4,
+	"CLP": 0,
+	"DJF": 0,
+	"GNF": 0,
+	"IQD": 3,
+	"ISK": 0,
+	"JOD": 3,
+	"JPY": 0,
+	"KMF": 0,
+	"KRW": 0,
+	"KWD": 3,
+	"LYD": 3,
+	"OMR": 3,
+	"PYG": 0,
+	"RWF": 0,
+	"TND": 3,
+	"UGX": 0,
+	"UYI": 0,
+	"VND": 0,
+	"VUV": 0,
+	"XAF": 0,
+	"XAG": -1,
+	"XAU": -1,
+	"XBA": -1,
+	"XBB": -1,
+	"XBC": -1,
+	"XBD": -1,
+	"XDR": -1,
+	"XOF": 0,
+	"XPD": -1,
+	"XPF": 0,
+	"XPT": -1,
+	"XSU": -1,
+	"XTS": -1,
+	"XUA": -1,
+	"XXX": -1,
+}
+
+// Rounding increments of cash payment of currencies (the smallest coin in circulation).
+// They are the cash rounding (or 1 for the cash digits 0) of CLDR currency data,
+// and AUD & NZD which are not in CLDR (the smallest coins are 5 and 10 cents).
+var currencyCashRoundings = map[string]float64{
+	"AMD": 1,
+	"AUD": 0.05,
+	"CAD": 0.05,
+	"CHF": 0.05,
+	"COP": 1,
+	"CRC": 1,
+	"CZK": 1,
+	"DKK": 0.5,
+	"HUF": 5,
+	"IDR": 1,
+	"MUR": 1,
+	"NOK": 1,
+	"NZD": 0.1,
+	"PKR": 1,
+	"SEK": 1,
+	"TWD": 1,
+}
@@ -0,0 +1,24 @@
+/*
+Package i18n provides utilities for internationalization.
+
+# Entities
+
+Countries, currencies, languages, cultures, regions and subdivisions are loaded from the embedded data at initialization,
+every entity is a single shared instance, so the entities can be compared by Equal (or by pointer).
+
+The only exception is the entities decoded by UnmarshalText (e.g. from JSON) or Scan (from database):
+the decoder writes into the value it is given, so the decoded entity is a copy of the shared instance.
+The copy shares the names and references with the shared instance, but it must be compared by Equal (not by pointer),
+or be replaced with the shared instance by the Lookup functions (e.g. LookupCountry(nil, decoded.Alpha2Code)).
+Decoding into a shared instance (e.g. a struct field holds the result of LookupCountry) returns an error,
+so the package tables are never overwritten by decoding.
+
+The lists returned by the All functions (e.g. AllCountries) and the methods of entities (e.g. Country.Subdivisions) are copies,
+sorting or modifying the lists never changes the package-level data.
+
+The entities themselves are shared by all goroutines:
+
+ 1. The code fields (e.g. Country.Alpha2Code, Culture.Code) and the references (e.g. Culture.Country) are read-only.
+ 2. Name, Aliases & Currency.PluralName (MultiLanguageString) are the only mutable fields, they are meant to be loaded by the application (e.g. at startup).
+*/
+package i18n
@@ -0,0 +1,186 @@
+package i18n
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"github.com/golang-plus/errors"
+	"github.com/golang-plus/math/big"
+)
+
+// The entities are encoded as their codes (e.g. "en-US", "DE", "EUR", "fr") by encoding/json, encoding/xml and other text based encodings,
+// and decoded via the Lookup functions.
+// The decoded entity is a copy of the entity in the package tables, compare it by Equal instead of by pointer (see the package doc).
+// Decoding into a shared instance (e.g. a field holds the result of LookupCountry) returns an error instead of overwriting the package tables,
+// decode into a nil pointer or a new entity instead.
+
+// sharedEntityError returns the error of decoding into the shared instance of entity.
+func sharedEntityError(entity, code string) error {
+	return errors.Newf("%s %s is a shared instance (it cannot be decoded into, use a nil pointer instead)", entity, code)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The culture is encoded as its code, e.g. en-US.
+func (c *Culture) MarshalText() ([]byte, error) {
+	return []byte(c.Code), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (c *Culture) UnmarshalText(text []byte) error {
+	if shared, ok := cultureTable[strings.ToLower(c.Code)]; ok && shared == c {
+		return sharedEntityError("culture", c.Code)
+	}
+	cult, ok := LookupCulture(string(text))
+	if !ok {
+		return errors.Newf("culture code %q is invalid", string(text))
+	}
+	*c = *cult
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The country is encoded as its alpha-2 code, e.g. DE.
+func (c *Country) MarshalText() ([]byte, error) {
+	return []byte(c.Alpha2Code), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The alpha-3 and numeric codes are accepted too.
+func (c *Country) UnmarshalText(text []byte) error {
+	if shared, ok := countryTableAlpha2[c.Alpha2Code]; ok && shared == c {
+		return sharedEntityError("country", c.Alpha2Code)
+	}
+	country, ok := LookupCountry(nil, string(text))
+	if !ok {
+		return errors.Newf("country code %q is invalid", string(text))
+	}
+	*c = *country
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The currency is encoded as its code, e.g. EUR.
+func (c *Currency) MarshalText() ([]byte, error) {
+	return []byte(c.Code), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (c *Currency) UnmarshalText(text []byte) error {
+	if shared, ok := currencyTable[c.Code]; ok && shared == c {
+		return sharedEntityError("currency", c.Code)
+	}
+	curr, ok := LookupCurrency(string(text))
+	if !ok {
+		return errors.Newf("currency code %q is invalid", string(text))
+	}
+	*c = *curr
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The language is encoded as its code, e.g. fr.
+func (l *Language) MarshalText() ([]byte, error) {
+	return []byte(l.Code), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (l *Language) UnmarshalText(text []byte) error {
+	if shared, ok := languageTable[strings.ToLower(l.Code)]; ok && shared == l {
+		return sharedEntityError("language", l.Code)
+	}
+	lang, ok := LookupLanguage(string(text))
+	if !ok {
+		return errors.Newf("language code %q is invalid", string(text))
+	}
+	*l = *lang
+	return nil
+}
+
+// exchangeableCurrencyJSON represents the JSON representation of exchangeable currency.
+type exchangeableCurrencyJSON struct {
+	Code string
+	Rate float64
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The exchangeable currency is encoded as {"Code":"USD","Rate":1.1} (instead of the code of embedded currency, the rate is kept).
+func (c ExchangeableCurrency) MarshalJSON() ([]byte, error) {
+	if c.Currency == nil {
+		return nil, errors.New("exchangeable currency is invalid (currency cannot be nil)")
+	}
+	return json.Marshal(&exchangeableCurrencyJSON{
+		Code: c.Code,
+		Rate: c.Rate,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The currency is validated via LookupCurrency, and the rate should greater than 0 (see NewExchangeableCurrency).
+// The other fields of the former encoding (e.g. Name) are ignored.
+func (c *ExchangeableCurrency) UnmarshalJSON(data []byte) error {
+	var v exchangeableCurrencyJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return errors.Newf("exchangeable currency %s is invalid (%s)", string(data), err)
+	}
+	ec, err := NewExchangeableCurrency(v.Code, v.Rate)
+	if err != nil {
+		return err
+	}
+	*c = *ec
+	return nil
+}
+
+// moneyJSON represents the JSON representation of money.
+type moneyJSON struct {
+	Amount   string `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The money is encoded as {"amount":"1234.50","currency":"EUR"},
+// the amount is a string (to avoid the loss of floating-point numbers) rounded to the minor unit of currency.
+// It has a value receiver, so the money stored by value (e.g. in a struct field) is encoded in the same way.
+func (m Money) MarshalJSON() ([]byte, error) {
+	if m.Currency == nil {
+		return nil, errors.New("money currency is invalid (it cannot be nil)")
+	}
+	digits := m.Currency.MinorUnit
+	if digits < 0 {
+		digits = int(m.Precision)
+	}
+	amount, _ := m.round(big.NewDecimal(m.Amount), digits).Float64()
+	return json.Marshal(&moneyJSON{
+		Amount:   strconv.FormatFloat(amount, 'f', digits, 64),
+		Currency: m.Currency.Code,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The currency is validated via LookupCurrency, and the amount (string or number) is rounded to the minor unit of currency,
+// which is the precision of money also.
+// The rate of currency is unknown (0) unless the money has the same currency already (the rate is kept).
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var v struct {
+		Amount   json.Number `json:"amount"`
+		Currency string      `json:"currency"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return errors.Newf("money %s is invalid (%s)", string(data), err)
+	}
+	if len(v.Amount) == 0 {
+		return errors.Newf("money %s is invalid (amount is required)", string(data))
+	}
+	if err := m.scanCurrency(v.Currency); err != nil {
+		return err
+	}
+	if err := m.scanAmount(v.Amount.String()); err != nil {
+		return err
+	}
+	m.Precision = DefaultMoneyPrecision
+	if m.Currency.MinorUnit >= 0 {
+		m.Precision = uint(m.Currency.MinorUnit)
+	}
+	m.Amount, _ = m.round(big.NewDecimal(m.Amount), -1).Float64()
+	return nil
+}
@@ -0,0 +1,148 @@
+package i18n
+
+import (
+	"encoding/json"
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestEncoding(t *testing.T) {
+	type profile struct {
+		Culture  *Culture
+		Country  *Country
+		Currency *Currency
+		Language *Language
+		Visited  []*Country `json:",omitempty"`
+	}
+
+	cult, _ := LookupCulture("en-US")
+	country, _ := LookupCountry(nil, "DE")
+	curr, _ := LookupCurrency("EUR")
+	lang, _ := LookupLanguage("fr")
+	data, err := json.Marshal(&profile{
+		Culture:  cult,
+		Country:  country,
+		Currency: curr,
+		Language: lang,
+	})
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, string(data), `{"Culture":"en-US","Country":"DE","Currency":"EUR","Language":"fr"}`)
+
+	var p profile
+	err = json.Unmarshal([]byte(`{"Culture":"en-us","Country":"FRA","Currency":"usd","Language":"fr","Visited":["JP","CN"]}`), &p)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, p.Culture.Code, "en-US")
+	testing2.AssertEqual(t, p.Culture.Country.Alpha2Code, "US")
+	testing2.AssertEqual(t, p.Country.Alpha2Code, "FR")
+	fr, _ := LookupCountry(nil, "FR")
+	testing2.AssertEqual(t, p.Country.Equal(fr), true) // a copy of the shared instance
+	testing2.AssertEqual(t, p.Country != fr, true)
+	testing2.AssertEqual(t, p.Currency.Code, "USD")
+	testing2.AssertEqual(t, p.Language.Equal(lang), true)
+	testing2.AssertEqual(t, len(p.Visited), 2)
+	testing2.AssertEqual(t, p.Visited[1].Alpha3Code, "CHN")
+
+	// decoding over a field holds the shared instance
+	de, _ := LookupCountry(nil, "DE")
+	p = profile{Country: de}
+	err = json.Unmarshal([]byte(`{"Country":"FR"}`), &p)
+	testing2.AssertEqual(t, err != nil, true)
+	de, _ = LookupCountry(nil, "DE")
+	testing2.AssertEqual(t, de.Alpha2Code, "DE")
+	testing2.AssertEqual(t, new(Culture).UnmarshalText([]byte("en-US")), nil)
+	testing2.AssertEqual(t, cult.UnmarshalText([]byte("fr-FR")) != nil, true)
+	testing2.AssertEqual(t, curr.UnmarshalText([]byte("USD")) != nil, true)
+	testing2.AssertEqual(t, lang.UnmarshalText([]byte("de")) != nil, true)
+	testing2.AssertEqual(t, cult.Code, "en-US")
+	testing2.AssertEqual(t, curr.Code, "EUR")
+	testing2.AssertEqual(t, lang.Code, "fr")
+
+	// unknown codes
+	err = json.Unmarshal([]byte(`{"Currency":"XYZ"}`), &p)
+	testing2.AssertEqual(t, err != nil, true)
+	err = json.Unmarshal([]byte(`{"Country":"Germany"}`), &p)
+	testing2.AssertEqual(t, err != nil, true)
+
+	// map keys
+	data, err = json.Marshal(map[*Currency]int{curr: 1})
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, string(data), `{"EUR":1}`)
+}
+
+func TestExchangeableCurrencyJSON(t *testing.T) {
+	usd := MustNewExchangeableCurrency("USD", 1.1)
+	data, err := json.Marshal(usd)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, string(data), `{"Code":"USD","Rate":1.1}`)
+	data, err = json.Marshal(struct{ Currency ExchangeableCurrency }{*usd}) // by value
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, string(data), `{"Currency":{"Code":"USD","Rate":1.1}}`)
+	_, err = json.Marshal(&ExchangeableCurrency{Rate: 1})
+	testing2.AssertEqual(t, err != nil, true)
+
+	var ec ExchangeableCurrency
+	err = json.Unmarshal([]byte(`{"Code":"EUR","Name":{"Values":{"en":"Euro"}},"Rate":0.9}`), &ec) // the former encoding
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, ec.Code, "EUR")
+	testing2.AssertEqual(t, ec.Rate, 0.9)
+	testing2.AssertEqual(t, json.Unmarshal([]byte(`"USD"`), new(ExchangeableCurrency)) != nil, true) // no rate
+	testing2.AssertEqual(t, json.Unmarshal([]byte(`{"Code":"USD","Rate":0}`), new(ExchangeableCurrency)) != nil, true)
+	testing2.AssertEqual(t, json.Unmarshal([]byte(`{"Code":"XYZ","Rate":1}`), new(ExchangeableCurrency)) != nil, true)
+
+	// database (blocked)
+	_, err = usd.Value()
+	testing2.AssertEqual(t, err != nil, true)
+	testing2.AssertEqual(t, new(ExchangeableCurrency).Scan("USD") != nil, true)
+}
+
+func TestMoneyJSON(t *testing.T) {
+	data := map[string]string{
+		"EUR": `{"amount":"1234.50","currency":"EUR"}`,
+		"JPY": `{"amount":"1234","currency":"JPY"}`, // to nearest even
+		"KWD": `{"amount":"1234.500","currency":"KWD"}`,
+	}
+	for code, expected := range data {
+		money := MustNewMoney(MustNewExchangeableCurrency(code, 1), 1234.5)
+		b, err := json.Marshal(money)
+		testing2.AssertEqual(t, err, nil)
+		testing2.AssertEqual(t, string(b), expected)
+	}
+
+	var m Money
+	testing2.AssertEqual(t, json.Unmarshal([]byte(`{"amount":"1234.505","currency":"eur"}`), &m), nil)
+	testing2.AssertEqual(t, m.String(), "EUR 1234.50")
+	testing2.AssertEqual(t, m.Precision, uint(2))
+	testing2.AssertEqual(t, json.Unmarshal([]byte(`{"amount":99.5,"currency":"JPY"}`), &m), nil)
+	testing2.AssertEqual(t, m.String(), "JPY 100")
+
+	// invalid
+	testing2.AssertEqual(t, json.Unmarshal([]byte(`{"amount":"1.00","currency":"XYZ"}`), &m) != nil, true)
+	testing2.AssertEqual(t, json.Unmarshal([]byte(`{"amount":"abc","currency":"EUR"}`), &m) != nil, true)
+	testing2.AssertEqual(t, json.Unmarshal([]byte(`{"currency":"EUR"}`), &m) != nil, true)
+
+	// round trip in struct
+	type order struct {
+		Total *Money `json:"total"`
+	}
+	var o order
+	testing2.AssertEqual(t, json.Unmarshal([]byte(`{"total":{"amount":"19.99","currency":"USD"}}`), &o), nil)
+	b, _ := json.Marshal(&o)
+	testing2.AssertEqual(t, string(b), `{"total":{"amount":"19.99","currency":"USD"}}`)
+
+	// stored by value (struct field, slice & map)
+	type line struct {
+		Price Money `json:"price"`
+	}
+	eur := MustNewExchangeableCurrency("EUR", 1)
+	b, err := json.Marshal(line{Price: *MustNewMoney(eur, 1.5)})
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, string(b), `{"price":{"amount":"1.50","currency":"EUR"}}`)
+	b, _ = json.Marshal([]Money{*MustNewMoney(eur, 2)})
+	testing2.AssertEqual(t, string(b), `[{"amount":"2.00","currency":"EUR"}]`)
+	b, _ = json.Marshal(map[string]Money{"fee": *MustNewMoney(eur, 0.5)})
+	testing2.AssertEqual(t, string(b), `{"fee":{"amount":"0.50","currency":"EUR"}}`)
+	var l line
+	testing2.AssertEqual(t, json.Unmarshal([]byte(`{"price":{"amount":"1.50","currency":"EUR"}}`), &l), nil)
+	testing2.AssertEqual(t, l.Price.String(), "EUR 1.50")
+}
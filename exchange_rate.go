@@ -0,0 +1,41 @@
+package i18n
+
+import (
+	"time"
+
+	"github.com/golang-plus/errors"
+	"github.com/golang-plus/math/big"
+)
+
+// ExchangeRateProvider represents the provider (the source of truth) of exchange rates.
+type ExchangeRateProvider interface {
+	// Rate returns the rate for exchanging 1 unit of from currency to currency at given time.
+	// It returns an error if the rate is unavailable.
+	Rate(from, to *Currency, at time.Time) (float64, error)
+}
+
+// ExchangeableCurrencies represents a list of exchangeable currencies.
+// It's an adapter of ExchangeRateProvider, the rates of currencies are rates against a same base currency and never change over time.
+type ExchangeableCurrencies []*ExchangeableCurrency
+
+// Rate returns the rate for exchanging 1 unit of from currency to currency.
+// It's part of ExchangeRateProvider, the time is ignored.
+func (ecs ExchangeableCurrencies) Rate(from, to *Currency, at time.Time) (float64, error) {
+	var fr, tr *ExchangeableCurrency
+	for _, ec := range ecs {
+		if fr == nil && ec.Currency.Equal(from) {
+			fr = ec
+		}
+		if tr == nil && ec.Currency.Equal(to) {
+			tr = ec
+		}
+	}
+	if fr == nil || tr == nil {
+		return 0, errors.Newf("exchange rate from %s to %s is unavailable", from.Code, to.Code)
+	}
+	if fr == tr || from.Equal(to) {
+		return 1, nil
+	}
+	rate, _ := big.NewDecimal(tr.Rate).Div(big.NewDecimal(fr.Rate)).Float64()
+	return rate, nil
+}
@@ -0,0 +1,55 @@
+package i18n
+
+import (
+	"testing"
+	"time"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+// fixedRateProvider provides the rates to USD.
+type fixedRateProvider map[string]float64
+
+func (p fixedRateProvider) Rate(from, to *Currency, at time.Time) (float64, error) {
+	if to.Code != "USD" {
+		return new(ExchangeableCurrencies).Rate(from, to, at)
+	}
+	return p[from.Code], nil
+}
+
+func TestExchangeRateProvider(t *testing.T) {
+	usd, _ := LookupCurrency("USD")
+	jpy, _ := LookupCurrency("JPY")
+	provider := fixedRateProvider{"EUR": 1.1, "GBP": 1.25}
+
+	eur := MustNewMoney(MustNewExchangeableCurrency("EUR", 0.9), 100)
+	money, err := eur.ExchangeWith(provider, usd)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, money.String(), "USD 110.0000")
+	testing2.AssertEqual(t, money.Currency.Rate, 0.99)    // the rate of EUR times the rate of provider
+	testing2.AssertEqual(t, eur.String(), "EUR 100.0000") // not changed
+	testing2.AssertEqual(t, money.Plus(eur).String(), "USD 220.0000")
+	testing2.AssertEqual(t, eur.Plus(money).String(), "EUR 200.0000")
+	_, err = eur.ExchangeWith(provider, jpy)
+	testing2.AssertEqual(t, err != nil, true)
+	_, err = eur.ExchangeWith(nil, usd)
+	testing2.AssertEqual(t, err != nil, true)
+
+	// same currency
+	money, err = eur.ExchangeWith(provider, eur.Currency.Currency)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, money != eur, true)
+	testing2.AssertEqual(t, money.String(), "EUR 100.0000")
+
+	// exchangeable currencies
+	ecs := ExchangeableCurrencies{
+		MustNewExchangeableCurrency("CNY", 1),
+		MustNewExchangeableCurrency("USD", 0.15),
+	}
+	rate, err := ecs.Rate(ecs[0].Currency, usd, time.Now())
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, rate, 0.15)
+	money, err = MustNewMoney(ecs[1], 3).ExchangeWith(ecs, ecs[0].Currency)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, money.String(), "CNY 20.0000")
+}
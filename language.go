@@ -23,20 +23,97 @@ type Languages []*Language
 
 // SortByCode sorts the list by code.
 func (l Languages) SortByCode() {
-	var byCode LanguageLessFunc = func(c1, c2 *Language) bool {
-		return c1.Code < c2.Code
+	l.SortBy(LanguageByCode)
+}
+
+// SortByName sorts the list by name with given language (and the collation of language).
+func (l Languages) SortByName(language *Language) {
+	l.sortByName(func(name *MultiLanguageString) string {
+		return name.Value(language)
+	}, newLanguageCollator(language))
+}
+
+// SortByNameWithCulture sorts the list by name of culture (falls back to the name in its language) with the collation of culture.
+func (l Languages) SortByNameWithCulture(culture *Culture) {
+	l.sortByName(func(name *MultiLanguageString) string {
+		return name.CultureValue(culture)
+	}, NewCollator(culture))
+}
+
+// sortByName sorts the list stably by name, the sort keys of names are computed once.
+func (l Languages) sortByName(value func(name *MultiLanguageString) string, collator *Collator) {
+	collator.sortByKeys(len(l), func(i int) (string, bool) {
+		if l[i] == nil {
+			return "", false
+		}
+		return value(l[i].Name), true
+	}, func(i, j int) {
+		l[i], l[j] = l[j], l[i]
+	})
+}
+
+// SortBy sorts the list stably by given orders, the later order is used only if the former orders are equal.
+func (l Languages) SortBy(orders ...LanguageCompareFunc) {
+	var byOrders LanguageLessFunc = func(l1, l2 *Language) bool {
+		for _, order := range orders {
+			if r := order(l1, l2); r != 0 {
+				return r < 0
+			}
+		}
+		return false
 	}
 
-	byCode.Sort(l)
+	byOrders.Sort(l)
 }
 
-// SortByName sorts the list by name with given language.
-func (l Languages) SortByName(language *Language) {
-	var byName LanguageLessFunc = func(c1, c2 *Language) bool {
-		return c1.Name.Value(language) < c2.Name.Value(language)
+// LanguageCompareFunc represents the compare function for ordering languages.
+// It returns -1 if l1 < l2, 0 if l1 == l2, +1 if l1 > l2 (nil sorts first).
+type LanguageCompareFunc func(l1, l2 *Language) int
+
+// Reverse returns the compare function of reverse order.
+func (lcf LanguageCompareFunc) Reverse() LanguageCompareFunc {
+	return func(l1, l2 *Language) int {
+		return lcf(l2, l1)
 	}
+}
 
-	byName.Sort(l)
+// LanguageByCode compares languages by code.
+var LanguageByCode LanguageCompareFunc = func(l1, l2 *Language) int {
+	if r, ok := compareNil(l1 == nil, l2 == nil); ok {
+		return r
+	}
+	return compareString(l1.Code, l2.Code)
+}
+
+// LanguageByNativeName compares languages by native name.
+var LanguageByNativeName LanguageCompareFunc = func(l1, l2 *Language) int {
+	if r, ok := compareNil(l1 == nil, l2 == nil); ok {
+		return r
+	}
+	return rootCollator.Compare(l1.NativeName, l2.NativeName)
+}
+
+// LanguageByName returns the compare function which compares languages by name with the collation of language.
+func LanguageByName(language *Language) LanguageCompareFunc {
+	return languageByName(func(name *MultiLanguageString) string {
+		return name.Value(language)
+	}, newLanguageCollator(language))
+}
+
+// LanguageByNameWithCulture returns the compare function which compares languages by name of culture (falls back to the name in its language) with the collation of culture.
+func LanguageByNameWithCulture(culture *Culture) LanguageCompareFunc {
+	return languageByName(func(name *MultiLanguageString) string {
+		return name.CultureValue(culture)
+	}, NewCollator(culture))
+}
+
+func languageByName(value func(name *MultiLanguageString) string, collator *Collator) LanguageCompareFunc {
+	return func(l1, l2 *Language) int {
+		if r, ok := compareNil(l1 == nil, l2 == nil); ok {
+			return r
+		}
+		return collator.Compare(value(l1.Name), value(l2.Name))
+	}
 }
 
 // languageSorter joins a LanguageLessFunc function and Languages to be sorted.
@@ -63,14 +140,14 @@ func (ls languageSorter) Less(i, j int) bool {
 // LanguageLessFunc represents the less function for sorting languages.
 type LanguageLessFunc func(l1, l2 *Language) bool
 
-// Sort is a method on the function type that sorts the argument slic according to the function.
+// Sort is a method on the function type that sorts the argument slic stably according to the function.
 func (llf LanguageLessFunc) Sort(list Languages) {
 	sorter := &languageSorter{
 		List:     list,
 		LessFunc: llf, // The sort method's receiver is the function (closure) that defines the sort order.
 	}
 
-	sort.Sort(sorter)
+	sort.Stable(sorter)
 }
 
 var (
@@ -92,11 +169,18 @@ func init() {
 		languageTable[strings.ToLower(v)] = language
 		languageList[i] = language
 	}
+	// cultures
+	for _, culture := range cultureList {
+		culture.Language = languageTable[strings.ToLower(culture.Code[0:strings.Index(culture.Code, "-")])]
+	}
 }
 
 // AllLanguages returns the list of all languages.
+// The list is a copy, it's safe to sort or modify the list (but not the languages in it).
 func AllLanguages() Languages {
-	return languageList
+	list := make(Languages, len(languageList))
+	copy(list, languageList)
+	return list
 }
 
 // LookupLanguage returns the language by given code.
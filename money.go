@@ -2,13 +2,19 @@ package i18n
 
 import (
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/golang-plus/errors"
 	"github.com/golang-plus/math/big"
 )
 
 // ExchangeableCurrency represents a currency with exchange rate.
+// The rates of currencies are rates against a same base currency (e.g. the rates of ExchangeableCurrencies).
+// The rate 0 means the rate is unknown (e.g. the currency of scanned or decoded money),
+// the money in such currency cannot be exchanged with the rates of currencies (use an ExchangeRateProvider instead).
 type ExchangeableCurrency struct {
 	*Currency
 	Rate float64
@@ -52,6 +58,11 @@ const (
 	MoneyRoundToNearestAway                              // to nearest away from zero
 	MoneyRoundToZero                                     // to zero
 	MoneyRoundAwayFromZero                               // away from zero
+	MoneyRoundHalfDown                                   // to nearest, ties toward zero
+	MoneyRoundCeiling                                    // toward positive infinity
+	MoneyRoundFloor                                      // toward negative infinity
+
+	MoneyRoundHalfUp = MoneyRoundToNearestAway // to nearest, ties away from zero
 )
 
 var (
@@ -67,9 +78,10 @@ type Money struct {
 	Precision    uint // number of decimal digits
 }
 
+// round rounds d to precision (the precision of money if precision < 0) with the rounding mode of money.
 func (m *Money) round(d *big.Decimal, precision int) *big.Decimal {
 	prec := m.Precision
-	if precision > 0 {
+	if precision >= 0 {
 		prec = uint(precision)
 	}
 	switch m.RoundingMode {
@@ -81,13 +93,35 @@ func (m *Money) round(d *big.Decimal, precision int) *big.Decimal {
 		d.RoundToZero(prec)
 	case MoneyRoundAwayFromZero:
 		d.RoundAwayFromZero(prec)
+	case MoneyRoundHalfDown:
+		// it's a tie if d is the midpoint of values rounded to zero & away from zero (compared exactly, 2d == z + a)
+		z := big.NewDecimal(0).Add(d).RoundToZero(prec)
+		a := big.NewDecimal(0).Add(d).RoundAwayFromZero(prec)
+		if big.NewDecimal(0).Add(d).Mul(big.NewDecimal(2)).Cmp(z.Add(a)) == 0 {
+			d.RoundToZero(prec)
+		} else {
+			d.RoundToNearestAway(prec)
+		}
+	case MoneyRoundCeiling:
+		if d.Sign() > 0 {
+			d.RoundAwayFromZero(prec)
+		} else {
+			d.RoundToZero(prec)
+		}
+	case MoneyRoundFloor:
+		if d.Sign() < 0 {
+			d.RoundAwayFromZero(prec)
+		} else {
+			d.RoundToZero(prec)
+		}
 	default:
 		d.RoundToNearestEven(prec)
 	}
 	return d
 }
 
-// Format returns the formatted string of money.
+// Format returns the formatted string of money (with the symbol pattern of formatter).
+// Use FormatWithOptions for other styles (e.g. ISO code, accounting).
 func (m *Money) Format(formatter *CurrencyFormatter) string {
 	amount := m.Amount
 	if uint(formatter.DecimalDigits) < m.Precision {
@@ -120,14 +154,24 @@ func (m *Money) IsZero() bool {
 func (x *Money) Copy(y *Money) *Money {
 	x.Currency = y.Currency
 	x.Amount = y.Amount
+	x.RoundingMode = y.RoundingMode
 	x.Precision = y.Precision
 	return x
 }
 
+// Exchange sets m to the money exchanged to currency with the rates of currencies then returns m.
+// Use ConvertTo to get a new money, or ExchangeWith to exchange with an ExchangeRateProvider.
+// It panics with a *CurrencyMismatchError if the rate of either currency is unknown.
 func (m *Money) Exchange(currency *ExchangeableCurrency) *Money {
 	if m.Currency.Equal(currency) {
 		return m
 	}
+	if m.Currency.Rate <= 0 || currency.Rate <= 0 {
+		panic(&CurrencyMismatchError{
+			X: currency.Currency,
+			Y: m.Currency.Currency,
+		})
+	}
 	sr := big.NewDecimal(m.Currency.Rate)
 	tr := big.NewDecimal(currency.Rate)
 	amount := m.round(big.NewDecimal(m.Amount).Div(sr).Mul(tr), -1)
@@ -136,7 +180,50 @@ func (m *Money) Exchange(currency *ExchangeableCurrency) *Money {
 	return m
 }
 
-// Cmp compares x and y and returns:
+// ExchangeWith returns a new money exchanged to target currency with the current rate of provider.
+// m is not changed.
+func (m *Money) ExchangeWith(provider ExchangeRateProvider, target *Currency) (*Money, error) {
+	return m.ExchangeAt(provider, target, time.Now())
+}
+
+// ExchangeAt returns a new money exchanged to target currency with the rate of provider at given time (e.g. the date of transaction).
+// m is not changed.
+// The rate of new currency is the rate of m times the rate of provider,
+// so the new money is consistent with the moneys in the rates of m (e.g. for Add or Plus).
+func (m *Money) ExchangeAt(provider ExchangeRateProvider, target *Currency, at time.Time) (*Money, error) {
+	if provider == nil {
+		return nil, errors.New("exchange rate provider is invalid (it cannot be nil)")
+	}
+	if target == nil {
+		return nil, errors.New("target currency is invalid (it cannot be nil)")
+	}
+
+	money := &Money{
+		Currency:     m.Currency,
+		Amount:       m.Amount,
+		RoundingMode: m.RoundingMode,
+		Precision:    m.Precision,
+	}
+	if m.Currency.Currency.Equal(target) {
+		return money, nil
+	}
+
+	rate, err := provider.Rate(m.Currency.Currency, target, at)
+	if err != nil {
+		return nil, err
+	}
+	if big.NewDecimal(rate).Sign() <= 0 {
+		return nil, errors.Newf("exchange rate %f from %s to %s is invalid (it should greater than 0)", rate, m.Currency.Code, target.Code)
+	}
+	money.Amount, _ = m.round(big.NewDecimal(m.Amount).Mul(big.NewDecimal(rate)), -1).Float64()
+	money.Currency = &ExchangeableCurrency{
+		Currency: target,
+	}
+	money.Currency.Rate, _ = big.NewDecimal(m.Currency.Rate).Mul(big.NewDecimal(rate)).Float64()
+	return money, nil
+}
+
+// Cmp compares x and y (exchanged with the rates of currencies) and returns:
 // -1 if x < y
 //  0 if x == y (includes: -0 == 0, -Inf == -Inf, and +Inf == +Inf)
 // +1 if x > y
@@ -144,7 +231,8 @@ func (x *Money) Cmp(y *Money) int {
 	return big.NewDecimal(x.Amount).Cmp(big.NewDecimal(new(Money).Copy(y).Exchange(x.Currency).Amount))
 }
 
-// Add sets amount to the sum of amount and x then returns m.
+// Add sets amount to the sum of amount and y (exchanged with the rates of currencies) then returns x.
+// Use AddStrict to refuse mixing currencies.
 func (x *Money) Add(y *Money) *Money {
 	amount := big.NewDecimal(x.Amount)
 	amount.Add(big.NewDecimal(new(Money).Copy(y).Exchange(x.Currency).Amount))
@@ -152,7 +240,8 @@ func (x *Money) Add(y *Money) *Money {
 	return x
 }
 
-// Sub sets amount to the difference x-y then returns x.
+// Sub sets amount to the difference x-y (y is exchanged with the rates of currencies) then returns x.
+// Use SubStrict to refuse mixing currencies.
 func (x *Money) Sub(y *Money) *Money {
 	amount := big.NewDecimal(x.Amount)
 	amount.Sub(big.NewDecimal(new(Money).Copy(y).Exchange(x.Currency).Amount))
@@ -160,6 +249,71 @@ func (x *Money) Sub(y *Money) *Money {
 	return x
 }
 
+// CurrencyMismatchError represents the error of operating moneys of different currencies without an exchange rate provider.
+type CurrencyMismatchError struct {
+	X *Currency
+	Y *Currency
+}
+
+// Error is part of error interface.
+func (e *CurrencyMismatchError) Error() string {
+	return fmt.Sprintf("currencies %s and %s are mismatched (exchange rate provider is required)", e.X.Code, e.Y.Code)
+}
+
+// operand returns the amount of y in the currency of x.
+// It returns a *CurrencyMismatchError if the currencies are different and the provider is nil.
+func (x *Money) operand(y *Money, provider ExchangeRateProvider) (*big.Decimal, error) {
+	if y == nil || y.Currency == nil {
+		return nil, errors.New("money is invalid (it cannot be nil)")
+	}
+	if x.Currency.Currency.Equal(y.Currency.Currency) {
+		return big.NewDecimal(y.Amount), nil
+	}
+	if provider == nil {
+		return nil, &CurrencyMismatchError{
+			X: x.Currency.Currency,
+			Y: y.Currency.Currency,
+		}
+	}
+	money, err := y.ExchangeWith(provider, x.Currency.Currency)
+	if err != nil {
+		return nil, err
+	}
+	return big.NewDecimal(money.Amount), nil
+}
+
+// CmpStrict is like as Cmp but returns a *CurrencyMismatchError if the currencies are different and the provider is nil,
+// y is exchanged with the provider otherwise.
+func (x *Money) CmpStrict(y *Money, provider ExchangeRateProvider) (int, error) {
+	amount, err := x.operand(y, provider)
+	if err != nil {
+		return 0, err
+	}
+	return big.NewDecimal(x.Amount).Cmp(amount), nil
+}
+
+// AddStrict is like as Add but returns a *CurrencyMismatchError if the currencies are different and the provider is nil,
+// y is exchanged with the provider otherwise. x is not changed if error occurred.
+func (x *Money) AddStrict(y *Money, provider ExchangeRateProvider) (*Money, error) {
+	amount, err := x.operand(y, provider)
+	if err != nil {
+		return nil, err
+	}
+	x.Amount, _ = big.NewDecimal(x.Amount).Add(amount).Float64()
+	return x, nil
+}
+
+// SubStrict is like as Sub but returns a *CurrencyMismatchError if the currencies are different and the provider is nil,
+// y is exchanged with the provider otherwise. x is not changed if error occurred.
+func (x *Money) SubStrict(y *Money, provider ExchangeRateProvider) (*Money, error) {
+	amount, err := x.operand(y, provider)
+	if err != nil {
+		return nil, err
+	}
+	x.Amount, _ = big.NewDecimal(x.Amount).Sub(amount).Float64()
+	return x, nil
+}
+
 // Mul sets amount to the product x*y and returns x.
 func (x *Money) Mul(y float64) *Money {
 	x.Amount, _ = x.round(big.NewDecimal(x.Amount).Mul(big.NewDecimal(y)), -1).Float64()
@@ -172,6 +326,138 @@ func (x *Money) Div(y float64) *Money {
 	return x
 }
 
+// The following functions return a new money, the operands are not changed.
+
+// Plus returns a new money of the sum x+y (y is exchanged with the rates of currencies).
+func (x *Money) Plus(y *Money) *Money {
+	return new(Money).Copy(x).Add(y)
+}
+
+// Minus returns a new money of the difference x-y (y is exchanged with the rates of currencies).
+func (x *Money) Minus(y *Money) *Money {
+	return new(Money).Copy(x).Sub(y)
+}
+
+// Times returns a new money of the product x*y.
+func (x *Money) Times(y float64) *Money {
+	return new(Money).Copy(x).Mul(y)
+}
+
+// DividedBy returns a new money of the quotient x/y.
+func (x *Money) DividedBy(y float64) *Money {
+	return new(Money).Copy(x).Div(y)
+}
+
+// ConvertTo returns a new money exchanged to currency with the rates of currencies.
+func (m *Money) ConvertTo(currency *ExchangeableCurrency) *Money {
+	return new(Money).Copy(m).Exchange(currency)
+}
+
+// RoundToIncrement returns a new money rounded to a multiple of increment (e.g. 0.05) with the rounding mode of money.
+// m is not changed.
+func (m *Money) RoundToIncrement(increment float64) *Money {
+	money := new(Money).Copy(m)
+	if big.NewDecimal(increment).Sign() <= 0 {
+		return money
+	}
+	quotient := m.round(big.NewDecimal(m.Amount).Div(big.NewDecimal(increment)), 0)
+	money.Amount, _ = quotient.Mul(big.NewDecimal(increment)).Float64()
+	return money
+}
+
+// CashRounded returns a new money rounded for cash payment with the rounding mode of money,
+// it's rounded to the cash rounding increment of currency (e.g. 0.05 for CHF), or the minor unit if the currency has no cash rounding.
+// m is not changed.
+func (m *Money) CashRounded() *Money {
+	if m.Currency.CashRounding > 0 {
+		return m.RoundToIncrement(m.Currency.CashRounding)
+	}
+	money := new(Money).Copy(m)
+	if m.Currency.MinorUnit >= 0 {
+		money.Amount, _ = m.round(big.NewDecimal(m.Amount), m.Currency.MinorUnit).Float64()
+	}
+	return money
+}
+
+// minorUnitPrecision returns the precision of minor unit of currency (the precision of money if the minor unit is not applicable or greater).
+func (m *Money) minorUnitPrecision() int {
+	if m.Currency.MinorUnit >= 0 && uint(m.Currency.MinorUnit) < m.Precision {
+		return m.Currency.MinorUnit
+	}
+	return int(m.Precision)
+}
+
+// The percentage functions return new moneys rounded to the minor unit of currency (e.g. cents) with the rounding mode of money,
+// the percentages are in percent (e.g. 20 for 20%). m is not changed.
+
+// Percent returns a new money of p percent of m.
+func (m *Money) Percent(p float64) *Money {
+	money := new(Money).Copy(m)
+	amount := big.NewDecimal(m.Amount).Mul(big.NewDecimal(p)).Div(big.NewDecimal(100))
+	money.Amount, _ = m.round(amount, m.minorUnitPrecision()).Float64()
+	return money
+}
+
+// ApplyTax returns the net amount, tax and gross amount of m with the tax rate (in percent).
+// m is the gross amount (tax included) if inclusive is true, the net amount (tax excluded) otherwise.
+// It's guaranteed that net + tax == gross in decimal arithmetic (e.g. net.Plus(tax).Cmp(gross) == 0),
+// but not for the float64 amounts (e.g. net.Amount + tax.Amount is 0.30000000000000004 if net is 0.1 and tax is 0.2).
+// It returns an error if the rate is negative (or not a number).
+func (m *Money) ApplyTax(rate float64, inclusive bool) (net, tax, gross *Money, err error) {
+	if math.IsNaN(rate) || math.IsInf(rate, 0) || rate < 0 {
+		return nil, nil, nil, errors.Newf("tax rate %f is invalid (it should not be negative)", rate)
+	}
+
+	digits := m.minorUnitPrecision()
+	if inclusive {
+		gross = new(Money).Copy(m)
+		gross.Amount, _ = m.round(big.NewDecimal(m.Amount), digits).Float64()
+		net = new(Money).Copy(m)
+		amount := big.NewDecimal(gross.Amount).Mul(big.NewDecimal(100)).Div(big.NewDecimal(100).Add(big.NewDecimal(rate)))
+		net.Amount, _ = m.round(amount, digits).Float64()
+		tax = new(Money).Copy(m)
+		tax.Amount, _ = big.NewDecimal(gross.Amount).Sub(big.NewDecimal(net.Amount)).Float64()
+		return net, tax, gross, nil
+	}
+
+	net = new(Money).Copy(m)
+	net.Amount, _ = m.round(big.NewDecimal(m.Amount), digits).Float64()
+	tax = net.Percent(rate)
+	gross = new(Money).Copy(m)
+	gross.Amount, _ = big.NewDecimal(net.Amount).Add(big.NewDecimal(tax.Amount)).Float64()
+	return net, tax, gross, nil
+}
+
+// Discount returns a new money of m with p percent off.
+// Both m and the discount are rounded to the minor unit first (see Percent),
+// so that the discounted money + the discount == m (rounded) in decimal arithmetic.
+func (m *Money) Discount(p float64) *Money {
+	money := new(Money).Copy(m)
+	money.Amount, _ = m.round(big.NewDecimal(m.Amount), m.minorUnitPrecision()).Float64()
+	money.Amount, _ = big.NewDecimal(money.Amount).Sub(big.NewDecimal(money.Percent(p).Amount)).Float64()
+	return money
+}
+
+// MinorUnits returns the amount in minor units of currency (e.g. cents), rounded with the rounding mode of money.
+// It returns an error if the currency has no minor unit (e.g. XAU) or the result overflows (cannot be represented exactly, see NewMoneyFromMinorUnits).
+func (m *Money) MinorUnits() (int64, error) {
+	digits := m.Currency.MinorUnit
+	if digits < 0 {
+		return 0, errors.Newf("currency %s has no minor unit", m.Currency.Code)
+	}
+	// checks the bound before rounding, the rounding of amount near the bound is not exact
+	if math.Abs(m.Amount)*math.Pow10(digits) > maxExactMinorUnits {
+		return 0, errors.Newf("money %s overflows minor units", m.String())
+	}
+	amount, _ := m.round(big.NewDecimal(m.Amount), digits).Float64()
+	s := strings.Replace(strconv.FormatFloat(amount, 'f', digits, 64), ".", "", 1)
+	units, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || units > maxExactMinorUnits || units < -maxExactMinorUnits {
+		return 0, errors.Newf("money %s overflows minor units", m.String())
+	}
+	return units, nil
+}
+
 // NewMoney returns a new money.
 func NewMoney(currency *ExchangeableCurrency, amount float64) (*Money, error) {
 	if currency == nil {
@@ -194,3 +480,43 @@ func MustNewMoney(currency *ExchangeableCurrency, amount float64) *Money {
 
 	return money
 }
+
+// maxExactMinorUnits is the maximum minor units which could be represented exactly in money (2^53).
+const maxExactMinorUnits = 1 << 53
+
+// NewMoneyFromMinorUnits returns a new money with the amount in minor units of currency (e.g. cents).
+// It returns an error if the currency has no minor unit (e.g. XAU) or the amount overflows (cannot be represented exactly).
+func NewMoneyFromMinorUnits(currency *ExchangeableCurrency, units int64) (*Money, error) {
+	if currency == nil {
+		return nil, errors.New("money currency is invalid (it cannot be nil)")
+	}
+	digits := currency.MinorUnit
+	if digits < 0 {
+		return nil, errors.Newf("currency %s has no minor unit", currency.Code)
+	}
+	if units > maxExactMinorUnits || units < -maxExactMinorUnits {
+		return nil, errors.Newf("minor units %d of %s overflows", units, currency.Code)
+	}
+
+	s := strconv.FormatInt(units, 10)
+	sign := ""
+	if units < 0 {
+		sign, s = "-", s[1:]
+	}
+	if len(s) <= digits {
+		s = strings.Repeat("0", digits-len(s)+1) + s
+	}
+	amount, err := strconv.ParseFloat(sign+s[:len(s)-digits]+"."+s[len(s)-digits:], 64)
+	if err != nil {
+		return nil, errors.Newf("minor units %d of %s overflows", units, currency.Code)
+	}
+
+	money, err := NewMoney(currency, amount)
+	if err != nil {
+		return nil, err
+	}
+	if money.Precision < uint(digits) {
+		money.Precision = uint(digits)
+	}
+	return money, nil
+}
@@ -0,0 +1,216 @@
+package i18n
+
+import (
+	"github.com/golang-plus/errors"
+	"github.com/golang-plus/math/big"
+)
+
+// The aggregate functions return a new money in the target currency, and the currencies of moneys (in the order of appearance).
+// The moneys of other currencies are exchanged with the provider,
+// it returns a *CurrencyMismatchError if the currencies are different and the provider is nil.
+// The target could be nil if all moneys are in the same currency.
+// The rounding mode & precision of result are of the first money.
+
+// exchangeMoneys returns the moneys exchanged to the target currency, and the currencies of moneys.
+func exchangeMoneys(moneys []*Money, target *Currency, provider ExchangeRateProvider) ([]*Money, Currencies, error) {
+	if len(moneys) == 0 {
+		return nil, nil, errors.New("moneys is invalid (it cannot be empty)")
+	}
+
+	var currencies Currencies
+	for _, m := range moneys {
+		if m == nil || m.Currency == nil {
+			return nil, nil, errors.New("money is invalid (it cannot be nil)")
+		}
+		found := false
+		for _, curr := range currencies {
+			if curr.Equal(m.Currency.Currency) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			currencies = append(currencies, m.Currency.Currency)
+		}
+	}
+	if target == nil {
+		if len(currencies) > 1 {
+			return nil, nil, &CurrencyMismatchError{
+				X: currencies[0],
+				Y: currencies[1],
+			}
+		}
+		target = currencies[0]
+	}
+
+	list := make([]*Money, len(moneys))
+	for i, m := range moneys {
+		if m.Currency.Currency.Equal(target) {
+			list[i] = new(Money).Copy(m)
+			continue
+		}
+		if provider == nil {
+			return nil, nil, &CurrencyMismatchError{
+				X: target,
+				Y: m.Currency.Currency,
+			}
+		}
+		money, err := m.ExchangeWith(provider, target)
+		if err != nil {
+			return nil, nil, err
+		}
+		list[i] = money
+	}
+
+	return list, currencies, nil
+}
+
+// SumMoney returns the sum of moneys in the target currency.
+func SumMoney(moneys []*Money, target *Currency, provider ExchangeRateProvider) (*Money, Currencies, error) {
+	list, currencies, err := exchangeMoneys(moneys, target, provider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sum := big.NewDecimal(0)
+	for _, m := range list {
+		sum.Add(big.NewDecimal(m.Amount))
+	}
+	money := list[0]
+	money.Amount, _ = sum.Float64()
+	return money, currencies, nil
+}
+
+// AverageMoney returns the average of moneys in the target currency.
+func AverageMoney(moneys []*Money, target *Currency, provider ExchangeRateProvider) (*Money, Currencies, error) {
+	money, currencies, err := SumMoney(moneys, target, provider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	money.Div(float64(len(moneys)))
+	return money, currencies, nil
+}
+
+// MinMoney returns the minimum of moneys in the target currency.
+func MinMoney(moneys []*Money, target *Currency, provider ExchangeRateProvider) (*Money, Currencies, error) {
+	return extremeMoney(moneys, target, provider, -1)
+}
+
+// MaxMoney returns the maximum of moneys in the target currency.
+func MaxMoney(moneys []*Money, target *Currency, provider ExchangeRateProvider) (*Money, Currencies, error) {
+	return extremeMoney(moneys, target, provider, 1)
+}
+
+// extremeMoney returns the minimum (sign: -1) or maximum (sign: 1) of moneys in the target currency.
+func extremeMoney(moneys []*Money, target *Currency, provider ExchangeRateProvider, sign int) (*Money, Currencies, error) {
+	list, currencies, err := exchangeMoneys(moneys, target, provider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	money := list[0]
+	for _, m := range list[1:] {
+		if big.NewDecimal(m.Amount).Cmp(big.NewDecimal(money.Amount)) == sign {
+			money = m
+		}
+	}
+	return money, currencies, nil
+}
+
+// MoneyBag represents a bag of moneys in different currencies.
+// It keeps the subtotals per currency without exchanging.
+// It's not safe for concurrent use.
+type MoneyBag struct {
+	subtotals []*Money // in the order of currencies added
+}
+
+// NewMoneyBag returns a new money bag with the moneys.
+func NewMoneyBag(moneys ...*Money) *MoneyBag {
+	mb := new(MoneyBag)
+	mb.Add(moneys...)
+	return mb
+}
+
+// Add adds the moneys to the subtotals of their currencies then returns mb.
+func (mb *MoneyBag) Add(moneys ...*Money) *MoneyBag {
+	for _, m := range moneys {
+		if subtotal, ok := mb.subtotal(m.Currency.Currency); ok {
+			subtotal.Amount, _ = big.NewDecimal(subtotal.Amount).Add(big.NewDecimal(m.Amount)).Float64()
+			continue
+		}
+		mb.subtotals = append(mb.subtotals, new(Money).Copy(m))
+	}
+	return mb
+}
+
+// Sub subtracts the moneys from the subtotals of their currencies then returns mb.
+func (mb *MoneyBag) Sub(moneys ...*Money) *MoneyBag {
+	for _, m := range moneys {
+		if subtotal, ok := mb.subtotal(m.Currency.Currency); ok {
+			subtotal.Amount, _ = big.NewDecimal(subtotal.Amount).Sub(big.NewDecimal(m.Amount)).Float64()
+			continue
+		}
+		subtotal := new(Money).Copy(m)
+		subtotal.Amount, _ = big.NewDecimal(0).Sub(big.NewDecimal(m.Amount)).Float64()
+		mb.subtotals = append(mb.subtotals, subtotal)
+	}
+	return mb
+}
+
+// subtotal returns the subtotal of currency.
+func (mb *MoneyBag) subtotal(currency *Currency) (*Money, bool) {
+	for _, subtotal := range mb.subtotals {
+		if subtotal.Currency.Currency.Equal(currency) {
+			return subtotal, true
+		}
+	}
+	return nil, false
+}
+
+// IsEmpty reports whether the bag is empty.
+func (mb *MoneyBag) IsEmpty() bool {
+	return len(mb.subtotals) == 0
+}
+
+// Currencies returns the currencies in the bag (in the order of added).
+func (mb *MoneyBag) Currencies() Currencies {
+	currencies := make(Currencies, len(mb.subtotals))
+	for i, subtotal := range mb.subtotals {
+		currencies[i] = subtotal.Currency.Currency
+	}
+	return currencies
+}
+
+// Subtotal returns the subtotal of currency.
+// The money is a copy, changing it will not change the bag.
+func (mb *MoneyBag) Subtotal(currency *Currency) (*Money, bool) {
+	if subtotal, ok := mb.subtotal(currency); ok {
+		return new(Money).Copy(subtotal), true
+	}
+	return nil, false
+}
+
+// Subtotals returns the subtotals per currency (in the order of added).
+// The moneys are copies, changing them will not change the bag.
+func (mb *MoneyBag) Subtotals() []*Money {
+	list := make([]*Money, len(mb.subtotals))
+	for i, subtotal := range mb.subtotals {
+		list[i] = new(Money).Copy(subtotal)
+	}
+	return list
+}
+
+// Total returns the total of subtotals in the target currency (see SumMoney).
+// The total of empty bag is zero in the target currency (with the default precision & rounding mode, and an unknown rate),
+// it returns an error if the bag is empty and the target is nil.
+func (mb *MoneyBag) Total(target *Currency, provider ExchangeRateProvider) (*Money, error) {
+	if mb.IsEmpty() && target != nil {
+		return NewMoney(&ExchangeableCurrency{
+			Currency: target,
+		}, 0)
+	}
+
+	money, _, err := SumMoney(mb.subtotals, target, provider)
+	return money, err
+}
@@ -0,0 +1,84 @@
+package i18n
+
+import (
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestMoneyAggregate(t *testing.T) {
+	eur := MustNewExchangeableCurrency("EUR", 1)
+	usd := MustNewExchangeableCurrency("USD", 1.25)
+	provider := ExchangeableCurrencies{eur, usd}
+	moneys := []*Money{
+		MustNewMoney(eur, 10),
+		MustNewMoney(usd, 25),
+		MustNewMoney(eur, 5.5),
+	}
+
+	sum, currencies, err := SumMoney(moneys, eur.Currency, provider)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, sum.String(), "EUR 35.5000")
+	testing2.AssertEqual(t, len(currencies), 2)
+	testing2.AssertEqual(t, currencies[1].Code, "USD")
+	testing2.AssertEqual(t, moneys[0].String(), "EUR 10.0000") // not changed
+
+	avg, _, err := AverageMoney(moneys, eur.Currency, provider)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, avg.String(), "EUR 11.8333")
+	minimum, _, err := MinMoney(moneys, eur.Currency, provider)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, minimum.String(), "EUR 5.5000")
+	maximum, _, err := MaxMoney(moneys, usd.Currency, provider)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, maximum.String(), "USD 25.0000")
+
+	// same currency without target & provider
+	sum, _, err = SumMoney([]*Money{moneys[0], moneys[2]}, nil, nil)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, sum.String(), "EUR 15.5000")
+
+	// mismatched
+	_, _, err = SumMoney(moneys, eur.Currency, nil)
+	_, ok := err.(*CurrencyMismatchError)
+	testing2.AssertEqual(t, ok, true)
+	_, _, err = MaxMoney(moneys, nil, provider)
+	testing2.AssertEqual(t, err != nil, true)
+	_, _, err = MinMoney(nil, eur.Currency, provider)
+	testing2.AssertEqual(t, err != nil, true)
+}
+
+func TestMoneyBag(t *testing.T) {
+	eur := MustNewExchangeableCurrency("EUR", 1)
+	usd := MustNewExchangeableCurrency("USD", 1.25)
+
+	mb := NewMoneyBag()
+	testing2.AssertEqual(t, mb.IsEmpty(), true)
+	total, err := mb.Total(eur.Currency, nil) // empty cart
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, total.String(), "EUR 0.0000")
+	testing2.AssertEqual(t, total.Currency.Rate, 0.0) // unknown rate
+	_, err = mb.Total(nil, nil)
+	testing2.AssertEqual(t, err != nil, true)
+
+	mb.Add(MustNewMoney(usd, 10), MustNewMoney(eur, 0.1), MustNewMoney(eur, 0.2))
+	mb.Sub(MustNewMoney(usd, 2.5))
+	testing2.AssertEqual(t, len(mb.Currencies()), 2)
+	testing2.AssertEqual(t, mb.Currencies()[0].Code, "USD")
+
+	subtotal, ok := mb.Subtotal(eur.Currency)
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, subtotal.Amount, 0.3)
+	subtotal.Amount = 100
+	subtotals := mb.Subtotals()
+	testing2.AssertEqual(t, subtotals[0].String(), "USD 7.5000")
+	testing2.AssertEqual(t, subtotals[1].String(), "EUR 0.3000")
+	_, ok = mb.Subtotal(currencyTable["JPY"])
+	testing2.AssertEqual(t, ok, false)
+
+	total, err = mb.Total(eur.Currency, ExchangeableCurrencies{eur, usd})
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, total.String(), "EUR 6.3000")
+	_, err = mb.Total(eur.Currency, nil)
+	testing2.AssertEqual(t, err != nil, true)
+}
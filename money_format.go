@@ -0,0 +1,118 @@
+package i18n
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang-plus/math/big"
+)
+
+// MoneyFormatStyle represents the style of currency in formatted money.
+type MoneyFormatStyle byte
+
+// Money Format Style List.
+const (
+	MoneyFormatSymbol MoneyFormatStyle = iota // symbol with the pattern of formatter, e.g. 1.234,50 €
+	MoneyFormatCode                           // ISO code, e.g. EUR 1.234,50
+	MoneyFormatName                           // name with plural form, e.g. 1,234.50 US dollars
+)
+
+// MoneyFormatOptions represents the options of money formatting.
+type MoneyFormatOptions struct {
+	Style            MoneyFormatStyle
+	Accounting       bool      // negative amount in parentheses, e.g. (€1,234.50)
+	ExplicitPlus     bool      // plus sign for positive amount, e.g. +€1,234.50
+	HideZeroDecimals bool      // no decimals if they are zeros, e.g. €5 instead of €5.00
+	Language         *Language // language of currency name (for MoneyFormatName style)
+}
+
+// FormatWithOptions returns the formatted string of money with options.
+// The separators, group sizes & decimal digits are of the formatter.
+// The name of currency (for MoneyFormatName style) is the plural name if the amount is plural in the language,
+// it falls back to the name and then the ISO code of currency if the name is not set.
+func (m *Money) FormatWithOptions(formatter *CurrencyFormatter, options *MoneyFormatOptions) string {
+	if options == nil {
+		options = new(MoneyFormatOptions)
+	}
+
+	digits := formatter.DecimalDigits
+	amount, _ := m.round(big.NewDecimal(m.Amount), digits).Float64()
+	if options.HideZeroDecimals && amount == math.Trunc(amount) {
+		digits = 0
+	}
+	negative := amount < 0
+	abs := math.Abs(amount)
+	nf := &CurrencyFormatter{
+		PositivePattern:  "n",
+		NegativePattern:  "-n",
+		DecimalDigits:    digits,
+		DecimalSeparator: formatter.DecimalSeparator,
+		GroupSizes:       formatter.GroupSizes,
+		GroupSeparator:   formatter.GroupSeparator,
+	}
+	number := nf.Format(abs)
+
+	var value string
+	switch options.Style {
+	case MoneyFormatCode:
+		value = m.Currency.Code + " " + number
+	case MoneyFormatName:
+		value = number + " " + m.currencyName(options.Language, abs, digits)
+	default:
+		pattern := formatter.PositivePattern
+		if negative && !options.Accounting {
+			pattern = formatter.NegativePattern
+		}
+		value = strings.Replace(strings.Replace(pattern, "n", number, -1), "$", formatter.Symbol, -1)
+	}
+
+	switch {
+	case negative && options.Accounting:
+		value = "(" + value + ")"
+	case negative && options.Style != MoneyFormatSymbol:
+		value = "-" + value
+	case amount > 0 && options.ExplicitPlus:
+		value = "+" + value
+	}
+	return value
+}
+
+// currencyName returns the name of currency for the amount in the language.
+func (m *Money) currencyName(language *Language, amount float64, digits int) string {
+	if language == nil {
+		return m.Currency.Code
+	}
+
+	var name string
+	if !isPluralOne(language, strconv.FormatFloat(amount, 'f', digits, 64)) {
+		name = m.Currency.PluralName.Value(language)
+	}
+	if len(name) == 0 {
+		name = m.Currency.Name.Value(language)
+	}
+	if len(name) == 0 {
+		name = m.Currency.Code
+	}
+	return name
+}
+
+// isPluralOne reports whether the plural category of the (non-negative) number is "one" in the language.
+// It's a simplified version of CLDR plural rules, only the "one" & "other" categories are distinguished.
+func isPluralOne(language *Language, number string) bool {
+	integer, fraction := number, ""
+	if index := strings.Index(number, "."); index >= 0 {
+		integer, fraction = number[:index], number[index+1:]
+	}
+
+	switch strings.ToLower(language.Code) {
+	case "ja", "ko", "zh", "th", "vi", "id", "ms", "my", "lo", "km": // no plural forms
+		return true
+	case "fr", "pt", "hi", "bn", "fa", "zu": // i = 0,1
+		return integer == "0" || integer == "1"
+	case "es", "el", "tr", "hu", "bg": // n = 1
+		return integer == "1" && len(strings.Trim(fraction, "0")) == 0
+	}
+	// i = 1 and v = 0 (e.g. en, de, nl, it, sv)
+	return integer == "1" && len(fraction) == 0
+}
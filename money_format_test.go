@@ -0,0 +1,59 @@
+package i18n
+
+import (
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestMoneyFormatWithOptions(t *testing.T) {
+	deDE, _ := LookupCulture("de-DE")
+	enUS, _ := LookupCulture("en-US")
+	english, _ := LookupLanguage("en")
+	french, _ := LookupLanguage("fr")
+
+	// names (on a copy of currency, the names of package are not changed)
+	usd := *currencyTable["USD"]
+	usd.Name = NewMultiLanguageString()
+	usd.PluralName = NewMultiLanguageString()
+	usd.Name.SetValue(english, "US dollar")
+	usd.PluralName.SetValue(english, "US dollars")
+	usd.Name.SetValue(french, "dollar des États-Unis")
+	usd.PluralName.SetValue(french, "dollars des États-Unis")
+	eur := MustNewExchangeableCurrency("EUR", 1)
+	dollar := &ExchangeableCurrency{Currency: &usd, Rate: 1}
+
+	de := deDE.Formatter.Currency
+	en := enUS.Formatter.Currency
+	data := []struct {
+		Money     *Money
+		Formatter *CurrencyFormatter
+		Options   *MoneyFormatOptions
+		Expected  string
+	}{
+		{MustNewMoney(eur, 1234.5), de, nil, "1.234,50 €"},
+		{MustNewMoney(eur, 1234.5), de, &MoneyFormatOptions{Style: MoneyFormatCode}, "EUR 1.234,50"},
+		{MustNewMoney(eur, -1234.5), de, &MoneyFormatOptions{Style: MoneyFormatCode}, "-EUR 1.234,50"},
+		{MustNewMoney(eur, -1234.5), de, &MoneyFormatOptions{Accounting: true}, "(1.234,50 €)"},
+		{MustNewMoney(eur, -1234.5), de, &MoneyFormatOptions{Style: MoneyFormatCode, Accounting: true}, "(EUR 1.234,50)"},
+		{MustNewMoney(eur, 1234.5), de, &MoneyFormatOptions{Accounting: true}, "1.234,50 €"},
+		{MustNewMoney(eur, 12), de, &MoneyFormatOptions{ExplicitPlus: true}, "+12,00 €"},
+		{MustNewMoney(eur, 0), de, &MoneyFormatOptions{ExplicitPlus: true}, "0,00 €"},
+		{MustNewMoney(eur, -0.001), de, nil, "0,00 €"},
+		{MustNewMoney(eur, 5), de, &MoneyFormatOptions{HideZeroDecimals: true}, "5 €"},
+		{MustNewMoney(eur, 5.5), de, &MoneyFormatOptions{HideZeroDecimals: true}, "5,50 €"},
+		{MustNewMoney(dollar, 1234.5), en, &MoneyFormatOptions{Style: MoneyFormatName, Language: english}, "1,234.50 US dollars"},
+		{MustNewMoney(dollar, 1), en, &MoneyFormatOptions{Style: MoneyFormatName, Language: english}, "1.00 US dollars"},
+		{MustNewMoney(dollar, 1), en, &MoneyFormatOptions{Style: MoneyFormatName, Language: english, HideZeroDecimals: true}, "1 US dollar"},
+		{MustNewMoney(dollar, -1), en, &MoneyFormatOptions{Style: MoneyFormatName, Language: english, HideZeroDecimals: true}, "-1 US dollar"},
+		{MustNewMoney(dollar, 1.5), de, &MoneyFormatOptions{Style: MoneyFormatName, Language: french}, "1,50 dollar des États-Unis"},
+		{MustNewMoney(dollar, 2), de, &MoneyFormatOptions{Style: MoneyFormatName, Language: french}, "2,00 dollars des États-Unis"},
+		{MustNewMoney(eur, 2), en, &MoneyFormatOptions{Style: MoneyFormatName, Language: english}, "2.00 EUR"}, // no name
+		{MustNewMoney(dollar, 2), en, &MoneyFormatOptions{Style: MoneyFormatName}, "2.00 USD"},
+	}
+	for _, v := range data {
+		testing2.AssertEqual(t, v.Money.FormatWithOptions(v.Formatter, v.Options), v.Expected)
+	}
+	testing2.AssertEqual(t, isPluralOne(english, "1"), true)
+	testing2.AssertEqual(t, isPluralOne(english, "1.00"), false)
+}
@@ -1,8 +1,10 @@
 package i18n
 
 import (
+	"math"
 	"testing"
 
+	"github.com/golang-plus/math/big"
 	testing2 "github.com/golang-plus/testing"
 )
 
@@ -67,9 +69,254 @@ func TestMoney(t *testing.T) {
 		testing2.AssertEqual(t, m.Format(cf), v)
 	}
 
+	// format with 0 decimal digits (rounded with the rounding mode of money)
+	cf.DecimalDigits = 0
+	m := MustNewMoney(usd, 1234.9)
+	m.RoundingMode = MoneyRoundToZero
+	testing2.AssertEqual(t, m.Format(cf), "$1,234")
+	m.RoundingMode = MoneyRoundAwayFromZero
+	testing2.AssertEqual(t, MustNewMoney(usd, 1234.1).Format(cf), "$1,234")
+	m.Amount = 1234.1
+	testing2.AssertEqual(t, m.Format(cf), "$1,235")
+
 	// test Add/Sub/Mul/Div
 	testing2.AssertEqual(t, MustNewMoney(cny, 100).Add(MustNewMoney(usd, 100)).String(), "CNY 766.6667")
 	testing2.AssertEqual(t, MustNewMoney(cny, 100).Sub(MustNewMoney(usd, 100)).String(), "CNY -566.6667")
 	testing2.AssertEqual(t, MustNewMoney(cny, 102.54321).Mul(2.5).String(), "CNY 256.3580")
 	testing2.AssertEqual(t, MustNewMoney(cny, 102.54321).Div(2.5).String(), "CNY 41.0173")
 }
+
+func TestMoneyStrict(t *testing.T) {
+	eur := MustNewExchangeableCurrency("EUR", 1)
+	usd := MustNewExchangeableCurrency("USD", 1.1)
+	provider := ExchangeableCurrencies{eur, usd}
+
+	money, err := MustNewMoney(eur, 100).AddStrict(MustNewMoney(eur, 50), nil)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, money.String(), "EUR 150.0000")
+	money, err = MustNewMoney(eur, 100).SubStrict(MustNewMoney(eur, 150), nil)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, money.String(), "EUR -50.0000")
+
+	// mismatched
+	x := MustNewMoney(eur, 100)
+	_, err = x.AddStrict(MustNewMoney(usd, 110), nil)
+	mismatch, ok := err.(*CurrencyMismatchError)
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, mismatch.Y.Code, "USD")
+	testing2.AssertEqual(t, x.String(), "EUR 100.0000") // not changed
+	_, err = x.SubStrict(MustNewMoney(usd, 110), nil)
+	testing2.AssertEqual(t, err != nil, true)
+	_, err = x.CmpStrict(MustNewMoney(usd, 110), nil)
+	testing2.AssertEqual(t, err != nil, true)
+	_, err = x.AddStrict(nil, provider)
+	testing2.AssertEqual(t, err != nil, true)
+
+	// with provider
+	money, err = x.AddStrict(MustNewMoney(usd, 110), provider)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, money.String(), "EUR 200.0000")
+	r, err := x.CmpStrict(MustNewMoney(usd, 220), provider)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, r, 0)
+}
+
+func TestMoneyImmutable(t *testing.T) {
+	cny := MustNewExchangeableCurrency("CNY", 1)
+	usd := MustNewExchangeableCurrency("USD", 0.15)
+
+	x := MustNewMoney(cny, 100)
+	x.RoundingMode = MoneyRoundToZero
+	y := MustNewMoney(usd, 100)
+	testing2.AssertEqual(t, x.Plus(y).String(), "CNY 766.6667")
+	testing2.AssertEqual(t, x.Minus(y).String(), "CNY -566.6667")
+	testing2.AssertEqual(t, x.Times(0.123456).String(), "CNY 12.3456")
+	testing2.AssertEqual(t, x.DividedBy(6).String(), "CNY 16.6666")
+	testing2.AssertEqual(t, x.ConvertTo(usd).String(), "USD 15.0000")
+	testing2.AssertEqual(t, x.String(), "CNY 100.0000")
+	testing2.AssertEqual(t, y.String(), "USD 100.0000")
+
+	// same currency
+	z := x.ConvertTo(cny)
+	testing2.AssertEqual(t, z != x, true)
+	z.Amount = 1
+	testing2.AssertEqual(t, x.Amount, 100.0)
+
+	// test Copy
+	testing2.AssertEqual(t, new(Money).Copy(x).RoundingMode, MoneyRoundToZero)
+}
+
+func TestMoneyRounding(t *testing.T) {
+	usd := MustNewExchangeableCurrency("USD", 1)
+	data := map[MoneyRoundingMode]map[float64]string{
+		MoneyRoundHalfUp: {
+			2.345:  "USD 2.35",
+			-2.345: "USD -2.35",
+			2.344:  "USD 2.34",
+		},
+		MoneyRoundHalfDown: {
+			2.345:  "USD 2.34",
+			-2.345: "USD -2.34",
+			2.3451: "USD 2.35",
+			2.346:  "USD 2.35",
+		},
+		MoneyRoundCeiling: {
+			2.341:  "USD 2.35",
+			-2.349: "USD -2.34",
+		},
+		MoneyRoundFloor: {
+			2.349:  "USD 2.34",
+			-2.341: "USD -2.35",
+		},
+	}
+	for mode, v := range data {
+		for amount, expected := range v {
+			m := MustNewMoney(usd, amount)
+			m.RoundingMode = mode
+			m.Precision = 2
+			testing2.AssertEqual(t, m.Times(1).String(), expected)
+		}
+	}
+
+	// ties are detected on the exact decimal (0.125 + 1e-20 is not a tie even if it's 0.125 in float64)
+	m := MustNewMoney(usd, 0)
+	m.RoundingMode = MoneyRoundHalfDown
+	d := m.round(big.NewDecimal(0.125).Add(big.NewDecimal(1e-20)), 2)
+	testing2.AssertEqual(t, d.Cmp(big.NewDecimal(0.13)), 0)
+	d = m.round(big.NewDecimal(0.125), 2)
+	testing2.AssertEqual(t, d.Cmp(big.NewDecimal(0.12)), 0)
+
+	// cash rounding
+	data2 := map[string]map[float64]string{
+		"CHF": {
+			12.32: "CHF 12.30",
+			12.33: "CHF 12.35",
+			12.38: "CHF 12.40",
+		},
+		"SEK": {
+			99.49: "SEK 99.00",
+			99.5:  "SEK 100.00",
+		},
+		"CAD": {
+			-1.02: "CAD -1.00",
+			1.03:  "CAD 1.05",
+		},
+		"HUF": {
+			1002: "HUF 1000.00",
+			1003: "HUF 1005.00",
+		},
+		"USD": {
+			1.005: "USD 1.00",
+			1.015: "USD 1.02",
+		},
+	}
+	for code, v := range data2 {
+		for amount, expected := range v {
+			m := MustNewMoney(MustNewExchangeableCurrency(code, 1), amount)
+			m.RoundingMode = MoneyRoundHalfUp
+			if code == "USD" {
+				m.RoundingMode = MoneyRoundToNearestEven
+			}
+			m.Precision = 2
+			testing2.AssertEqual(t, m.CashRounded().String(), expected)
+			testing2.AssertEqual(t, m.Amount, amount)
+		}
+	}
+	testing2.AssertEqual(t, MustNewMoney(usd, 7.3).RoundToIncrement(0.25).String(), "USD 7.2500")
+}
+
+func TestMoneyPercentage(t *testing.T) {
+	eur := MustNewExchangeableCurrency("EUR", 1)
+	jpy := MustNewExchangeableCurrency("JPY", 130)
+
+	m := MustNewMoney(eur, 19.99)
+	testing2.AssertEqual(t, m.Percent(15).String(), "EUR 3.0000")
+	testing2.AssertEqual(t, MustNewMoney(eur, 0.35).Percent(10).String(), "EUR 0.0400") // 0.035 to nearest even
+	testing2.AssertEqual(t, MustNewMoney(jpy, 1234).Percent(8).String(), "JPY 99.0000")
+	testing2.AssertEqual(t, m.Discount(15).String(), "EUR 16.9900")
+	testing2.AssertEqual(t, MustNewMoney(eur, 19.999).Discount(10).String(), "EUR 18.0000") // 20.00 - 2.00
+	testing2.AssertEqual(t, m.String(), "EUR 19.9900")
+
+	// VAT exclusive
+	net, tax, gross, err := MustNewMoney(eur, 10.05).ApplyTax(19, false)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, net.String(), "EUR 10.0500")
+	testing2.AssertEqual(t, tax.String(), "EUR 1.9100")
+	testing2.AssertEqual(t, gross.String(), "EUR 11.9600")
+
+	// VAT inclusive
+	net, tax, gross, err = MustNewMoney(eur, 9.99).ApplyTax(20, true)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, net.String(), "EUR 8.3200")
+	testing2.AssertEqual(t, tax.String(), "EUR 1.6700")
+	testing2.AssertEqual(t, gross.String(), "EUR 9.9900")
+	for _, amount := range []float64{0.01, 0.3, 0.99, 1.17, 123.45, 9999.99} {
+		for _, rate := range []float64{21, 200} {
+			net, tax, gross, _ = MustNewMoney(eur, amount).ApplyTax(rate, true)
+			sum := big.NewDecimal(net.Amount).Add(big.NewDecimal(tax.Amount))
+			testing2.AssertEqual(t, sum.Cmp(big.NewDecimal(gross.Amount)), 0) // in decimal arithmetic
+			testing2.AssertEqual(t, net.Plus(tax).Cmp(gross), 0)
+		}
+	}
+	_, _, _, err = MustNewMoney(eur, 9.99).ApplyTax(-100, true) // 100 + rate is 0
+	testing2.AssertEqual(t, err != nil, true)
+	_, _, _, err = MustNewMoney(eur, 9.99).ApplyTax(-1, false)
+	testing2.AssertEqual(t, err != nil, true)
+	_, _, _, err = MustNewMoney(eur, 9.99).ApplyTax(math.NaN(), false)
+	testing2.AssertEqual(t, err != nil, true)
+
+	// rounding mode
+	m = MustNewMoney(eur, 0.35)
+	m.RoundingMode = MoneyRoundFloor
+	testing2.AssertEqual(t, m.Percent(10).String(), "EUR 0.0300")
+}
+
+func TestMoneyMinorUnits(t *testing.T) {
+	data := map[string]map[float64]int64{
+		"USD": {
+			19.99:   1999,
+			-0.01:   -1,
+			0.125:   12, // to nearest even
+			1.005:   100,
+			1234567: 123456700,
+		},
+		"JPY": {1234: 1234, 99.5: 100},
+		"KWD": {1.2345: 1234},
+	}
+	for code, v := range data {
+		for amount, expected := range v {
+			units, err := MustNewMoney(MustNewExchangeableCurrency(code, 1), amount).MinorUnits()
+			testing2.AssertEqual(t, err, nil)
+			testing2.AssertEqual(t, units, expected)
+		}
+	}
+	_, err := MustNewMoney(MustNewExchangeableCurrency("USD", 1), 1e17).MinorUnits()
+	testing2.AssertEqual(t, err != nil, true)
+	_, err = MustNewMoney(MustNewExchangeableCurrency("USD", 1), 90071992547409.93).MinorUnits() // > 2^53 cents
+	testing2.AssertEqual(t, err != nil, true)
+	_, err = MustNewMoney(MustNewExchangeableCurrency("USD", 1), -90071992547409.93).MinorUnits()
+	testing2.AssertEqual(t, err != nil, true)
+	_, err = MustNewMoney(MustNewExchangeableCurrency("XAU", 1), 1).MinorUnits()
+	testing2.AssertEqual(t, err != nil, true)
+
+	data2 := map[string]map[int64]string{
+		"USD": {1999: "USD 19.9900", -1: "USD -0.0100", 0: "USD 0.0000", -123456: "USD -1234.5600"},
+		"JPY": {1234: "JPY 1234.0000"},
+		"KWD": {5: "KWD 0.0050"},
+	}
+	for code, v := range data2 {
+		for units, expected := range v {
+			money, err := NewMoneyFromMinorUnits(MustNewExchangeableCurrency(code, 1), units)
+			testing2.AssertEqual(t, err, nil)
+			testing2.AssertEqual(t, money.String(), expected)
+			back, _ := money.MinorUnits()
+			testing2.AssertEqual(t, back, units)
+		}
+	}
+	_, err = NewMoneyFromMinorUnits(MustNewExchangeableCurrency("USD", 1), 1<<62)
+	testing2.AssertEqual(t, err != nil, true)
+	_, err = NewMoneyFromMinorUnits(MustNewExchangeableCurrency("XDR", 1), 1)
+	testing2.AssertEqual(t, err != nil, true)
+	_, err = NewMoneyFromMinorUnits(nil, 1)
+	testing2.AssertEqual(t, err != nil, true)
+}
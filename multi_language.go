@@ -1,26 +1,55 @@
 package i18n
 
 import (
+	"encoding/json"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 // MultiLanguageString represents a string supports multi-language.
+// The values are keyed by language (e.g. en) or culture (e.g. en-GB, any language tag is allowed),
+// so a culture could have a regional value different from the value of its language (e.g. color & colour).
+// It's safe for concurrent use, and it's lock-free for reading after frozen (see Freeze).
 type MultiLanguageString struct {
-	Values map[string]string
+	mutex  sync.RWMutex
+	frozen int32             // 1 if frozen (accessed atomically)
+	values map[string]string // key: normalized (lower-cased) language code or tag
 }
 
-// SupportedLanguages returns the supported languages.
+// multiLanguageGeneration is increased whenever a value of any multi-language string is set (accessed atomically),
+// the caches derived from the values (e.g. the index of country names) are rebuilt if it's changed.
+var multiLanguageGeneration uint64
+
+// normalizeTag returns the normalized (lower-cased, hyphen separated) language tag.
+func normalizeTag(tag string) string {
+	return strings.ToLower(strings.Replace(strings.TrimSpace(tag), "_", "-", -1))
+}
+
+// rlock locks for reading if the string is not frozen, it reports whether locked.
+func (mlf *MultiLanguageString) rlock() bool {
+	if atomic.LoadInt32(&mlf.frozen) == 1 {
+		return false
+	}
+
+	mlf.mutex.RLock()
+	return true
+}
+
+// SupportedLanguages returns the supported languages (values keyed by culture are not included).
 func (mlf *MultiLanguageString) SupportedLanguages() Languages {
-	if len(mlf.Values) == 0 {
+	if mlf.rlock() {
+		defer mlf.mutex.RUnlock()
+	}
+
+	if len(mlf.values) == 0 {
 		return nil
 	}
 
-	langs := make(Languages, len(mlf.Values))
-	index := 0
-	for langCode, _ := range mlf.Values {
+	langs := make(Languages, 0, len(mlf.values))
+	for langCode := range mlf.values {
 		if lang, ok := LookupLanguage(langCode); ok {
-			langs[index] = lang
-			index += 1
+			langs = append(langs, lang)
 		}
 	}
 
@@ -29,32 +58,181 @@ func (mlf *MultiLanguageString) SupportedLanguages() Languages {
 
 // IsEmpty reports whether the values is empty.
 func (mlf *MultiLanguageString) IsEmpty() bool {
-	return len(mlf.Values) == 0
+	if mlf.rlock() {
+		defer mlf.mutex.RUnlock()
+	}
+
+	return len(mlf.values) == 0
 }
 
 // Value returns the value of string with given language.
+// It returns an empty string if language is nil.
 func (mlf *MultiLanguageString) Value(language *Language) string {
-	for lang, val := range mlf.Values {
-		if strings.EqualFold(lang, language.Code) {
+	if language == nil {
+		return ""
+	}
+	if mlf.rlock() {
+		defer mlf.mutex.RUnlock()
+	}
+
+	return mlf.values[strings.ToLower(language.Code)]
+}
+
+// SupportedCultures returns the cultures which have their own values (values keyed by language are not included).
+func (mlf *MultiLanguageString) SupportedCultures() Cultures {
+	if mlf.rlock() {
+		defer mlf.mutex.RUnlock()
+	}
+
+	var cults Cultures
+	for tag := range mlf.values {
+		if cult, ok := LookupCulture(tag); ok {
+			cults = append(cults, cult)
+		}
+	}
+
+	return cults
+}
+
+// CultureValue returns the value of string with given culture.
+// It prefers the value of culture, then falls back to the value of its language.
+// It returns an empty string if culture is nil.
+func (mlf *MultiLanguageString) CultureValue(culture *Culture) string {
+	if culture == nil {
+		return ""
+	}
+	return mlf.TagValue(culture.Code)
+}
+
+// TagValue returns the value of string with given language tag (e.g. zh-Hant-TW).
+// It prefers the value of exact tag, then falls back by truncating the tag (e.g. zh-Hant-TW -> zh-Hant -> zh).
+func (mlf *MultiLanguageString) TagValue(tag string) string {
+	if mlf.rlock() {
+		defer mlf.mutex.RUnlock()
+	}
+
+	key := normalizeTag(tag)
+	for len(key) > 0 {
+		if val, ok := mlf.values[key]; ok {
 			return val
 		}
+
+		index := strings.LastIndex(key, "-")
+		if index < 0 {
+			break
+		}
+		key = key[:index]
 	}
 
 	return ""
 }
 
+// ToMap returns a copy of the values (key: lower-cased language code or tag).
+func (mlf *MultiLanguageString) ToMap() map[string]string {
+	if mlf.rlock() {
+		defer mlf.mutex.RUnlock()
+	}
+
+	values := make(map[string]string, len(mlf.values))
+	for lang, val := range mlf.values {
+		values[lang] = val
+	}
+
+	return values
+}
+
+// SetMap replaces the values with a copy of values (key: language code or tag), it's the replacement of assigning the removed Values field.
+// It panics if the string is frozen.
+func (mlf *MultiLanguageString) SetMap(values map[string]string) {
+	mlf.mutex.Lock()
+	defer mlf.mutex.Unlock()
+
+	if atomic.LoadInt32(&mlf.frozen) == 1 {
+		panic("i18n: set value of frozen multi-language string")
+	}
+
+	mlf.values = make(map[string]string, len(values))
+	for tag, val := range values {
+		if len(val) > 0 {
+			mlf.values[normalizeTag(tag)] = val
+		}
+	}
+	atomic.AddUint64(&multiLanguageGeneration, 1)
+}
+
+// multiLanguageStringJSON represents the JSON of multi-language string, it's same as the JSON of the former struct with exported Values field.
+type multiLanguageStringJSON struct {
+	Values    map[string]string
+	Separator string `json:",omitempty"` // for MultiLanguageStringArray
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The values are encoded as {"Values":{"en":"Germany"}}.
+func (mlf *MultiLanguageString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&multiLanguageStringJSON{
+		Values: mlf.ToMap(),
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It panics if the string is frozen.
+func (mlf *MultiLanguageString) UnmarshalJSON(data []byte) error {
+	var v multiLanguageStringJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	mlf.SetMap(v.Values)
+	return nil
+}
+
 // SetValue sets the value with language.
+// It panics if the string is frozen.
 func (mlf *MultiLanguageString) SetValue(language *Language, value string) {
-	if mlf.Values == nil {
-		mlf.Values = make(map[string]string)
+	mlf.SetTagValue(language.Code, value)
+}
+
+// SetCultureValue sets the value with culture.
+// It panics if the string is frozen.
+func (mlf *MultiLanguageString) SetCultureValue(culture *Culture, value string) {
+	mlf.SetTagValue(culture.Code, value)
+}
+
+// SetTagValue sets the value with language tag (language code or culture code).
+// It panics if the string is frozen.
+func (mlf *MultiLanguageString) SetTagValue(tag string, value string) {
+	mlf.mutex.Lock()
+	defer mlf.mutex.Unlock()
+
+	if atomic.LoadInt32(&mlf.frozen) == 1 {
+		panic("i18n: set value of frozen multi-language string")
 	}
 
+	if mlf.values == nil {
+		mlf.values = make(map[string]string)
+	}
+
+	key := normalizeTag(tag)
+	defer atomic.AddUint64(&multiLanguageGeneration, 1)
 	if len(value) == 0 {
-		delete(mlf.Values, language.Code)
+		delete(mlf.values, key)
 		return
 	}
 
-	mlf.Values[language.Code] = value
+	mlf.values[key] = value
+}
+
+// Freeze makes the string read-only, reading the frozen string needs no lock.
+// It's designed for the freeze-after-load usage: load the values at startup, then freeze it.
+func (mlf *MultiLanguageString) Freeze() {
+	mlf.mutex.Lock()
+	defer mlf.mutex.Unlock()
+
+	atomic.StoreInt32(&mlf.frozen, 1)
+}
+
+// IsFrozen reports whether the string is frozen.
+func (mlf *MultiLanguageString) IsFrozen() bool {
+	return atomic.LoadInt32(&mlf.frozen) == 1
 }
 
 // NewMultiLanguageString returns a new multi-language string.
@@ -79,12 +257,55 @@ func (mlsa *MultiLanguageStringArray) Values(language *Language) []string {
 	return vals
 }
 
+// CultureValues returns values by given culture (falls back to the values of its language).
+func (mlsa *MultiLanguageStringArray) CultureValues(culture *Culture) []string {
+	val := mlsa.MultiLanguageString.CultureValue(culture)
+	if len(val) == 0 {
+		return nil
+	}
+
+	vals := strings.Split(val, mlsa.Separator)
+	return vals
+}
+
+// SetCultureValues sets the values with culture.
+func (mlsa *MultiLanguageStringArray) SetCultureValues(culture *Culture, values []string) {
+	vals := strings.Join(values, mlsa.Separator)
+	mlsa.MultiLanguageString.SetCultureValue(culture, vals)
+}
+
 // SetValues sets the values.
 func (mlsa *MultiLanguageStringArray) SetValues(language *Language, values []string) {
 	vals := strings.Join(values, mlsa.Separator)
 	mlsa.MultiLanguageString.SetValue(language, vals)
 }
 
+// MarshalJSON implements the json.Marshaler interface.
+// The values are encoded as {"Values":{"en":"UK,Great Britain"},"Separator":","}.
+func (mlsa *MultiLanguageStringArray) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&multiLanguageStringJSON{
+		Values:    mlsa.MultiLanguageString.ToMap(),
+		Separator: mlsa.Separator,
+	})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It panics if the string is frozen.
+func (mlsa *MultiLanguageStringArray) UnmarshalJSON(data []byte) error {
+	var v multiLanguageStringJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if mlsa.MultiLanguageString == nil {
+		mlsa.MultiLanguageString = NewMultiLanguageString()
+	}
+	mlsa.MultiLanguageString.SetMap(v.Values)
+	if len(v.Separator) > 0 {
+		mlsa.Separator = v.Separator
+	}
+	return nil
+}
+
 // NewMultiLanguageStringArray returns a new multi-language string array.
 // Default separator sets to comma (,) if empty separator passed.
 func NewMultiLanguageStringArray(separator string) *MultiLanguageStringArray {
@@ -98,3 +319,25 @@ func NewMultiLanguageStringArray(separator string) *MultiLanguageStringArray {
 		Separator:           sep,
 	}
 }
+
+// FreezeNames freezes the names (and aliases) of all countries, currencies, languages, cultures and regions.
+// It should be called after the names are loaded, the names cannot be changed after frozen.
+func FreezeNames() {
+	for _, country := range countryList {
+		country.Name.Freeze()
+		country.Aliases.Freeze()
+	}
+	for _, currency := range currencyList {
+		currency.Name.Freeze()
+		currency.PluralName.Freeze()
+	}
+	for _, language := range languageList {
+		language.Name.Freeze()
+	}
+	for _, culture := range cultureList {
+		culture.Name.Freeze()
+	}
+	for _, region := range regionList {
+		region.Name.Freeze()
+	}
+}
@@ -0,0 +1,181 @@
+package i18n
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestMultiLanguageString(t *testing.T) {
+	english, _ := LookupLanguage("en")
+	french, _ := LookupLanguage("fr")
+
+	mls := NewMultiLanguageString()
+	testing2.AssertEqual(t, mls.IsEmpty(), true)
+	mls.SetValue(english, "Germany")
+	mls.SetValue(french, "Allemagne")
+	testing2.AssertEqual(t, mls.Value(english), "Germany")
+	testing2.AssertEqual(t, mls.ToMap(), map[string]string{"en": "Germany", "fr": "Allemagne"})
+	testing2.AssertEqual(t, len(mls.SupportedLanguages()), 2)
+	mls.SetValue(french, "")
+	testing2.AssertEqual(t, mls.Value(french), "")
+
+	// test Freeze
+	mls.Freeze()
+	testing2.AssertEqual(t, mls.IsFrozen(), true)
+	testing2.AssertEqual(t, mls.Value(english), "Germany")
+	func() {
+		defer func() {
+			testing2.AssertEqual(t, recover() != nil, true)
+		}()
+		mls.SetValue(english, "Deutschland")
+	}()
+	testing2.AssertEqual(t, mls.Value(english), "Germany")
+}
+
+func TestMultiLanguageStringCultureValue(t *testing.T) {
+	english, _ := LookupLanguage("en")
+	enUS, _ := LookupCulture("en-US")
+	enGB, _ := LookupCulture("en-GB")
+	enAU, _ := LookupCulture("en-AU")
+
+	mls := NewMultiLanguageString()
+	mls.SetValue(english, "color")
+	mls.SetCultureValue(enGB, "colour")
+	testing2.AssertEqual(t, mls.Value(english), "color")
+	testing2.AssertEqual(t, mls.CultureValue(enUS), "color")
+	testing2.AssertEqual(t, mls.CultureValue(enGB), "colour")
+	testing2.AssertEqual(t, mls.TagValue("en_gb"), "colour")
+	testing2.AssertEqual(t, mls.CultureValue(nil), "")
+	testing2.AssertEqual(t, mls.Value(nil), "")
+	testing2.AssertEqual(t, NewMultiLanguageStringArray(",").Values(nil), []string(nil))
+	testing2.AssertEqual(t, len(mls.SupportedLanguages()), 1)
+	testing2.AssertEqual(t, len(mls.SupportedCultures()), 1)
+
+	// falls back by truncating the tag
+	mls.SetTagValue("zh-Hant", "顏色")
+	testing2.AssertEqual(t, mls.TagValue("zh-Hant-TW"), "顏色")
+	testing2.AssertEqual(t, mls.TagValue("zh-Hans-CN"), "")
+
+	mlsa := NewMultiLanguageStringArray(",")
+	mlsa.SetValues(english, []string{"fall"})
+	mlsa.SetCultureValues(enGB, []string{"autumn"})
+	testing2.AssertEqual(t, mlsa.CultureValues(enAU), []string{"fall"})
+	testing2.AssertEqual(t, mlsa.CultureValues(enGB), []string{"autumn"})
+}
+
+func TestMultiLanguageStringMap(t *testing.T) {
+	english, _ := LookupLanguage("en")
+
+	mls := NewMultiLanguageString()
+	mls.SetMap(map[string]string{"en": "Germany", "de_DE": "Deutschland", "fr": ""})
+	testing2.AssertEqual(t, mls.ToMap(), map[string]string{"en": "Germany", "de-de": "Deutschland"})
+
+	// JSON of the former struct with exported Values field
+	data, err := json.Marshal(mls)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, string(data), `{"Values":{"de-de":"Deutschland","en":"Germany"}}`)
+	mls = NewMultiLanguageString()
+	err = json.Unmarshal([]byte(`{"Values":{"EN":"Germany"}}`), mls)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, mls.Value(english), "Germany")
+
+	mlsa := NewMultiLanguageStringArray(",")
+	mlsa.SetValues(english, []string{"UK", "Great Britain"})
+	data, err = json.Marshal(mlsa)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, string(data), `{"Values":{"en":"UK,Great Britain"},"Separator":","}`)
+	mlsa = new(MultiLanguageStringArray)
+	err = json.Unmarshal(data, mlsa)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, mlsa.Values(english), []string{"UK", "Great Britain"})
+}
+
+// TestMultiLanguageStringConcurrency should be run with the race detector (go test -race).
+func TestMultiLanguageStringConcurrency(t *testing.T) {
+	english, _ := LookupLanguage("en")
+	countries := AllCountries()[:20]
+	defer func() {
+		for _, country := range countries {
+			country.Name.SetValue(english, "")
+			country.Aliases.SetValues(english, nil)
+		}
+	}()
+
+	var wg sync.WaitGroup
+	// loads names
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 10; i++ {
+			for _, country := range countries {
+				country.Name.SetValue(english, "Country "+country.Alpha3Code)
+				country.Aliases.SetValues(english, []string{country.Alpha3Code + " alias"})
+			}
+		}
+	}()
+	// lookups
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 10; j++ {
+				LookupCountry(english, "Country AFG")
+				SearchCountries(english, "country", 5)
+				list := AllCountries()
+				list.SortByName(english)
+			}
+		}()
+	}
+	wg.Wait()
+
+	country, ok := LookupCountry(english, "Country AFG")
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, country.Alpha2Code, "AF")
+}
+
+// loadEnglishNames sets the English names of all countries (name is the alpha-3 code with prefix) and returns the clean up function.
+func loadEnglishNames() func() {
+	english, _ := LookupLanguage("en")
+	for _, country := range countryList {
+		country.Name.SetValue(english, "Country "+country.Alpha3Code)
+	}
+	return func() {
+		for _, country := range countryList {
+			country.Name.SetValue(english, "")
+		}
+	}
+}
+
+func BenchmarkMultiLanguageStringValue(b *testing.B) {
+	defer loadEnglishNames()()
+	english, _ := LookupLanguage("en")
+	country, _ := LookupCountry(nil, "DE")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		country.Name.Value(english)
+	}
+}
+
+func BenchmarkCountriesSortByName(b *testing.B) {
+	defer loadEnglishNames()()
+	english, _ := LookupLanguage("en")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		list := AllCountries()
+		b.StartTimer()
+		list.SortByName(english)
+	}
+}
+
+func BenchmarkLookupCountryByName(b *testing.B) {
+	defer loadEnglishNames()()
+	english, _ := LookupLanguage("en")
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		LookupCountry(english, "Country ZWE")
+	}
+}
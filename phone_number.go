@@ -0,0 +1,282 @@
+package i18n
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/golang-plus/errors"
+)
+
+// phoneNumberMetadata represents the numbering plan of a country.
+type phoneNumberMetadata struct {
+	TrunkPrefix string     // national trunk prefix (empty if no trunk prefix)
+	Pattern     string     // regular expression of national significant number
+	Formats     [][]string // leading digits, national template, international template
+
+	pattern *regexp.Regexp
+	leading []*regexp.Regexp
+}
+
+// format formats the national significant number with national (or international) template.
+// It returns empty string if no template matched.
+func (pnm *phoneNumberMetadata) format(number string, international bool) string {
+	for i, format := range pnm.Formats {
+		if !pnm.leading[i].MatchString(number) {
+			continue
+		}
+
+		template := format[1]
+		if international {
+			template = format[2]
+		}
+		if strings.Count(template, "x") != len(number) {
+			continue
+		}
+
+		value := []byte(template)
+		index := 0
+		for j, c := range value {
+			if c == 'x' {
+				value[j] = number[index]
+				index += 1
+			}
+		}
+		return string(value)
+	}
+
+	return ""
+}
+
+var (
+	phoneNumberMetadataTable map[string]*phoneNumberMetadata // key: country alpha-2 code
+	nanpAreaCodeTable        map[string]*Country             // key: area code
+)
+
+func init() {
+	phoneNumberMetadataTable = make(map[string]*phoneNumberMetadata)
+	for code, metadata := range phoneNumberMetadatas {
+		metadata.pattern = regexp.MustCompile(`^(?:` + metadata.Pattern + `)$`)
+		metadata.leading = make([]*regexp.Regexp, len(metadata.Formats))
+		for i, format := range metadata.Formats {
+			metadata.leading[i] = regexp.MustCompile(`^(?:` + format[0] + `)`)
+		}
+
+		phoneNumberMetadataTable[code] = metadata
+	}
+
+	nanpAreaCodeTable = make(map[string]*Country)
+	for code, areaCodes := range nanpAreaCodes {
+		country := countryTableAlpha2[code]
+		for _, areaCode := range areaCodes {
+			nanpAreaCodeTable[areaCode] = country
+		}
+	}
+}
+
+// lookupPhoneNumberMetadata returns the phone number metadata of the country.
+func lookupPhoneNumberMetadata(country *Country) (*phoneNumberMetadata, bool) {
+	if country == nil {
+		return nil, false
+	}
+	if metadata, ok := phoneNumberMetadataTable[country.Alpha2Code]; ok {
+		return metadata, true
+	}
+	for _, callingCode := range country.CallingCodes {
+		if callingCode == "+1" {
+			return phoneNumberMetadataTable["US"], true
+		}
+	}
+	return nil, false
+}
+
+// PhoneNumber represents a phone number. ITU-T E.164
+type PhoneNumber struct {
+	Country        *Country
+	CallingCode    string // international calling code, e.g. +44
+	NationalNumber string // national significant number (digits only, without trunk prefix)
+}
+
+// IsValid reports whether the number is valid for its country.
+// The national number is checked with the numbering plan if the country has, otherwise only the length is checked.
+func (p *PhoneNumber) IsValid() bool {
+	if p.Country == nil || len(p.CallingCode) < 2 || len(p.NationalNumber) == 0 {
+		return false
+	}
+	if !isDigits(p.NationalNumber) {
+		return false
+	}
+	length := len(p.CallingCode) - 1 + len(p.NationalNumber) // E.164: 15 digits maximum
+	if length > 15 {
+		return false
+	}
+	if metadata, ok := lookupPhoneNumberMetadata(p.Country); ok {
+		return metadata.pattern.MatchString(p.NationalNumber)
+	}
+	return len(p.NationalNumber) >= 4
+}
+
+// E164 returns the number in E.164 format, e.g. +442079460958.
+func (p *PhoneNumber) E164() string {
+	return p.CallingCode + p.NationalNumber
+}
+
+// String returns the number in E.164 format.
+func (p *PhoneNumber) String() string {
+	return p.E164()
+}
+
+// FormatNational returns the number in national format, e.g. 020 7946 0958.
+// It returns the national number as is if the number has no country (see IsValid).
+func (p *PhoneNumber) FormatNational() string {
+	metadata, ok := lookupPhoneNumberMetadata(p.Country)
+	if !ok {
+		return p.NationalNumber
+	}
+	if value := metadata.format(p.NationalNumber, false); len(value) > 0 {
+		return value
+	}
+	return metadata.TrunkPrefix + p.NationalNumber
+}
+
+// FormatInternational returns the number in international format, e.g. +44 20 7946 0958.
+// The national number is not formatted if the number has no country (see IsValid).
+func (p *PhoneNumber) FormatInternational() string {
+	if metadata, ok := lookupPhoneNumberMetadata(p.Country); ok {
+		if value := metadata.format(p.NationalNumber, true); len(value) > 0 {
+			return p.CallingCode + " " + value
+		}
+	}
+	return p.CallingCode + " " + p.NationalNumber
+}
+
+// ParsePhoneNumber parses the phone number.
+// The number is parsed as an international number if it starts with + or 00 (011 for North American Numbering Plan),
+// otherwise as a national number of the default country.
+func ParsePhoneNumber(s string, defaultCountry *Country) (*PhoneNumber, error) {
+	number := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')', '/', '\u00a0':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(s))
+
+	international := false
+	switch {
+	case strings.HasPrefix(number, "+"):
+		number = number[1:]
+		international = true
+	case strings.HasPrefix(number, "00"):
+		number = number[2:]
+		international = true
+	case strings.HasPrefix(number, "011") && defaultCountry != nil && len(defaultCountry.CallingCodes) > 0 && defaultCountry.CallingCodes[0] == "+1":
+		number = number[3:]
+		international = true
+	}
+	if len(number) == 0 || !isDigits(number) {
+		return nil, errors.Newf("phone number %q is invalid", s)
+	}
+
+	var pn *PhoneNumber
+	if international {
+		for i := 1; i <= 3 && i < len(number); i++ {
+			countries, ok := countryTableCalling[number[:i]]
+			if !ok {
+				continue
+			}
+
+			nationalNumber := number[i:]
+			country := lookupPhoneNumberCountry(countries, nationalNumber, defaultCountry)
+			if metadata, ok := lookupPhoneNumberMetadata(country); ok && metadata.TrunkPrefix == "0" {
+				nationalNumber = strings.TrimPrefix(nationalNumber, "0") // e.g. +44 (0)20 7946 0958
+			}
+			pn = &PhoneNumber{
+				Country:        country,
+				CallingCode:    "+" + number[:i],
+				NationalNumber: nationalNumber,
+			}
+			break
+		}
+		if pn == nil {
+			return nil, errors.Newf("phone number %q is invalid (unknown calling code)", s)
+		}
+	} else {
+		if defaultCountry == nil || len(defaultCountry.CallingCodes) == 0 {
+			return nil, errors.Newf("phone number %q is invalid (default country is required for national number)", s)
+		}
+
+		nationalNumber := number
+		if metadata, ok := lookupPhoneNumberMetadata(defaultCountry); ok && len(metadata.TrunkPrefix) > 0 && strings.HasPrefix(number, metadata.TrunkPrefix) {
+			if trimmed := number[len(metadata.TrunkPrefix):]; metadata.pattern.MatchString(trimmed) || !metadata.pattern.MatchString(number) {
+				nationalNumber = trimmed
+			}
+		}
+		pn = &PhoneNumber{
+			Country:        defaultCountry,
+			CallingCode:    defaultCountry.CallingCodes[0],
+			NationalNumber: nationalNumber,
+		}
+	}
+
+	if !pn.IsValid() {
+		return nil, errors.Newf("phone number %q is invalid for country %s", s, pn.Country.Alpha2Code)
+	}
+	return pn, nil
+}
+
+// lookupPhoneNumberCountry returns the country of the national number from countries which share the same calling code.
+func lookupPhoneNumberCountry(countries Countries, number string, defaultCountry *Country) *Country {
+	if len(countries) == 1 {
+		return countries[0]
+	}
+	// area code of North American Numbering Plan
+	if len(number) >= 3 {
+		if country, ok := nanpAreaCodeTable[number[:3]]; ok {
+			for _, c := range countries {
+				if c.Equal(country) {
+					return country
+				}
+			}
+		}
+		// the area codes of countries other than US are all in the table, so the other valid numbers are of US
+		for _, country := range countries {
+			if country.Alpha2Code == "US" && phoneNumberMetadataTable["US"].pattern.MatchString(number) {
+				return country
+			}
+		}
+	}
+	// default country
+	if defaultCountry != nil {
+		for _, country := range countries {
+			if country.Equal(defaultCountry) {
+				return country
+			}
+		}
+	}
+	// numbering plan
+	for _, country := range countries {
+		metadata, ok := phoneNumberMetadataTable[country.Alpha2Code]
+		if !ok {
+			continue
+		}
+		if metadata.pattern.MatchString(number) || (metadata.TrunkPrefix == "0" && metadata.pattern.MatchString(strings.TrimPrefix(number, "0"))) {
+			return country
+		}
+	}
+	for _, country := range countries {
+		if _, ok := phoneNumberMetadataTable[country.Alpha2Code]; ok {
+			return country
+		}
+	}
+	return countries[0]
+}
+
+// isDigits reports whether the string consists of ASCII digits only.
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,312 @@
+package i18n
+
+// phone number metadata for country (alpha-2 code).
+// Formats: leading digits (regular expression), national template, international template (x: digit of national significant number).
+// The countries that share the calling code +1 use the metadata of US (North American Numbering Plan).
+var phoneNumberMetadatas = map[string]*phoneNumberMetadata{
+	"AE": {
+		TrunkPrefix: "0",
+		Pattern:     `[2-9]\d{7,8}`,
+		Formats: [][]string{
+			{`5`, "0xx xxx xxxx", "xx xxx xxxx"},
+			{``, "0x xxx xxxx", "x xxx xxxx"},
+		},
+	},
+	"AR": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{9}`,
+		Formats: [][]string{
+			{`11`, "0xx xxxx-xxxx", "xx xxxx-xxxx"},
+			{``, "0xxx xxx-xxxx", "xxx xxx-xxxx"},
+		},
+	},
+	"AT": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{3,12}`,
+		Formats: [][]string{
+			{`6`, "0xxx xxxxxxx", "xxx xxxxxxx"},
+			{`1`, "0x xxxxxxx", "x xxxxxxx"},
+		},
+	},
+	"AU": {
+		TrunkPrefix: "0",
+		Pattern:     `[2-478]\d{8}`,
+		Formats: [][]string{
+			{`4`, "0xxx xxx xxx", "xxx xxx xxx"},
+			{``, "0x xxxx xxxx", "x xxxx xxxx"},
+		},
+	},
+	"BE": {
+		TrunkPrefix: "0",
+		Pattern:     `4\d{8}|[1-9]\d{7}`,
+		Formats: [][]string{
+			{`4`, "0xxx xx xx xx", "xxx xx xx xx"},
+			{``, "0x xxx xx xx", "x xxx xx xx"},
+		},
+	},
+	"BR": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]{2}\d{8,9}`,
+		Formats: [][]string{
+			{``, "(xx) xxxxx-xxxx", "xx xxxxx-xxxx"},
+			{``, "(xx) xxxx-xxxx", "xx xxxx-xxxx"},
+		},
+	},
+	"CH": {
+		TrunkPrefix: "0",
+		Pattern:     `[2-9]\d{8}`,
+		Formats: [][]string{
+			{``, "0xx xxx xx xx", "xx xxx xx xx"},
+		},
+	},
+	"CN": {
+		TrunkPrefix: "0",
+		Pattern:     `1[3-9]\d{9}|[2-9]\d{9,10}`,
+		Formats: [][]string{
+			{`1`, "xxx xxxx xxxx", "xxx xxxx xxxx"},
+			{`2`, "0xx xxxx xxxx", "xx xxxx xxxx"},
+			{``, "0xxx xxxx xxxx", "xxx xxxx xxxx"},
+			{``, "0xxx xxx xxxx", "xxx xxx xxxx"},
+		},
+	},
+	"DE": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{5,13}`,
+		Formats: [][]string{
+			{`1[5-7]`, "0xxx xxxxxxxx", "xxx xxxxxxxx"},
+			{`1[5-7]`, "0xxx xxxxxxx", "xxx xxxxxxx"},
+			{`30|40|69|89`, "0xx xxxxxxxx", "xx xxxxxxxx"},
+			{``, "0xxxx xxxxxx", "xxxx xxxxxx"},
+			{``, "0xxxx xxxxxxx", "xxxx xxxxxxx"},
+		},
+	},
+	"DK": {
+		Pattern: `[2-9]\d{7}`,
+		Formats: [][]string{
+			{``, "xx xx xx xx", "xx xx xx xx"},
+		},
+	},
+	"ES": {
+		Pattern: `[5-9]\d{8}`,
+		Formats: [][]string{
+			{``, "xxx xx xx xx", "xxx xx xx xx"},
+		},
+	},
+	"FI": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{4,11}`,
+		Formats: [][]string{
+			{`4|50`, "0xx xxx xxxx", "xx xxx xxxx"},
+			{`4|50`, "0xx xxxx xxxx", "xx xxxx xxxx"},
+		},
+	},
+	"FR": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{8}`,
+		Formats: [][]string{
+			{``, "0x xx xx xx xx", "x xx xx xx xx"},
+		},
+	},
+	"GB": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{8,9}`,
+		Formats: [][]string{
+			{`2`, "0xx xxxx xxxx", "xx xxxx xxxx"},
+			{``, "0xxxx xxxxxx", "xxxx xxxxxx"},
+			{``, "0xxxx xxxxx", "xxxx xxxxx"},
+		},
+	},
+	"HK": {
+		Pattern: `[2-9]\d{7}`,
+		Formats: [][]string{
+			{``, "xxxx xxxx", "xxxx xxxx"},
+		},
+	},
+	"IE": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{6,9}`,
+		Formats: [][]string{
+			{`8`, "0xx xxx xxxx", "xx xxx xxxx"},
+			{`1`, "0x xxx xxxx", "x xxx xxxx"},
+		},
+	},
+	"IL": {
+		TrunkPrefix: "0",
+		Pattern:     `[2-9]\d{7,8}`,
+		Formats: [][]string{
+			{`5`, "0xx-xxx-xxxx", "xx-xxx-xxxx"},
+			{``, "0x-xxx-xxxx", "x-xxx-xxxx"},
+		},
+	},
+	"IN": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{9}`,
+		Formats: [][]string{
+			{`[6-9]`, "0xxxxx xxxxx", "xxxxx xxxxx"},
+			{``, "0xx xxxx xxxx", "xx xxxx xxxx"},
+		},
+	},
+	"IT": {
+		Pattern: `0\d{5,10}|3\d{8,9}`,
+		Formats: [][]string{
+			{`3`, "xxx xxx xxxx", "xxx xxx xxxx"},
+			{`0[26]`, "xx xxxx xxxx", "xx xxxx xxxx"},
+			{`0`, "xxx xxx xxxx", "xxx xxx xxxx"},
+		},
+	},
+	"JP": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{8,9}`,
+		Formats: [][]string{
+			{`[789]0`, "0xx-xxxx-xxxx", "xx-xxxx-xxxx"},
+			{`[36]`, "0x-xxxx-xxxx", "x-xxxx-xxxx"},
+			{``, "0xx-xxx-xxxx", "xx-xxx-xxxx"},
+		},
+	},
+	"KR": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{7,9}`,
+		Formats: [][]string{
+			{`1`, "0xx-xxxx-xxxx", "xx-xxxx-xxxx"},
+			{`2`, "0x-xxxx-xxxx", "x-xxxx-xxxx"},
+			{`2`, "0x-xxx-xxxx", "x-xxx-xxxx"},
+			{``, "0xx-xxx-xxxx", "xx-xxx-xxxx"},
+		},
+	},
+	"KZ": {
+		TrunkPrefix: "8",
+		Pattern:     `[67]\d{9}`,
+		Formats: [][]string{
+			{``, "8 (xxx) xxx-xx-xx", "xxx xxx xx xx"},
+		},
+	},
+	"MX": {
+		Pattern: `[1-9]\d{9}`,
+		Formats: [][]string{
+			{`33|55|81`, "xx xxxx xxxx", "xx xxxx xxxx"},
+			{``, "xxx xxx xxxx", "xxx xxx xxxx"},
+		},
+	},
+	"NL": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{8}`,
+		Formats: [][]string{
+			{`6`, "0x xxxxxxxx", "x xxxxxxxx"},
+			{``, "0xx xxx xxxx", "xx xxx xxxx"},
+		},
+	},
+	"NO": {
+		Pattern: `[2-9]\d{7}`,
+		Formats: [][]string{
+			{`[49]`, "xxx xx xxx", "xxx xx xxx"},
+			{``, "xx xx xx xx", "xx xx xx xx"},
+		},
+	},
+	"NZ": {
+		TrunkPrefix: "0",
+		Pattern:     `[2-9]\d{7,9}`,
+		Formats: [][]string{
+			{`2`, "0xx xxx xxxx", "xx xxx xxxx"},
+			{`2`, "0xx xxx xxx", "xx xxx xxx"},
+			{``, "0x xxx xxxx", "x xxx xxxx"},
+		},
+	},
+	"PL": {
+		Pattern: `[1-9]\d{8}`,
+		Formats: [][]string{
+			{``, "xxx xxx xxx", "xxx xxx xxx"},
+		},
+	},
+	"PT": {
+		Pattern: `[29]\d{8}`,
+		Formats: [][]string{
+			{``, "xxx xxx xxx", "xxx xxx xxx"},
+		},
+	},
+	"RU": {
+		TrunkPrefix: "8",
+		Pattern:     `[3489]\d{9}`,
+		Formats: [][]string{
+			{``, "8 (xxx) xxx-xx-xx", "xxx xxx-xx-xx"},
+		},
+	},
+	"SA": {
+		TrunkPrefix: "0",
+		Pattern:     `[15]\d{8}`,
+		Formats: [][]string{
+			{`5`, "0xx xxx xxxx", "xx xxx xxxx"},
+			{``, "0xx xxx xxxx", "xx xxx xxxx"},
+		},
+	},
+	"SE": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{6,9}`,
+		Formats: [][]string{
+			{`7`, "0xx-xxx xx xx", "xx-xxx xx xx"},
+			{`8`, "0x-xxx xxx xx", "x-xxx xxx xx"},
+		},
+	},
+	"SG": {
+		Pattern: `[3689]\d{7}`,
+		Formats: [][]string{
+			{``, "xxxx xxxx", "xxxx xxxx"},
+		},
+	},
+	"TR": {
+		TrunkPrefix: "0",
+		Pattern:     `[2-58]\d{9}`,
+		Formats: [][]string{
+			{``, "0xxx xxx xx xx", "xxx xxx xx xx"},
+		},
+	},
+	"TW": {
+		TrunkPrefix: "0",
+		Pattern:     `[2-9]\d{7,8}`,
+		Formats: [][]string{
+			{`9`, "0xxx xxx xxx", "xxx xxx xxx"},
+			{``, "0x xxxx xxxx", "x xxxx xxxx"},
+		},
+	},
+	"US": {
+		TrunkPrefix: "1",
+		Pattern:     `[2-9]\d{2}[2-9]\d{6}`,
+		Formats: [][]string{
+			{``, "(xxx) xxx-xxxx", "xxx-xxx-xxxx"},
+		},
+	},
+	"ZA": {
+		TrunkPrefix: "0",
+		Pattern:     `[1-9]\d{8}`,
+		Formats: [][]string{
+			{``, "0xx xxx xxxx", "xx xxx xxxx"},
+		},
+	},
+}
+
+// area codes of the North American Numbering Plan for the countries (alpha-2 code) other than US.
+var nanpAreaCodes = map[string][]string{
+	"AG": {"268"},
+	"AI": {"264"},
+	"AS": {"684"},
+	"BB": {"246"},
+	"BM": {"441"},
+	"BS": {"242"},
+	"CA": {"204", "226", "236", "249", "250", "263", "289", "306", "343", "354", "365", "367", "368", "382", "387", "403", "416", "418", "428", "431", "437", "438", "450", "468", "474", "506", "514", "519", "548", "579", "581", "584", "587", "604", "613", "639", "647", "672", "683", "705", "709", "742", "753", "778", "780", "782", "807", "819", "825", "867", "873", "879", "902", "905"},
+	"DM": {"767"},
+	"DO": {"809", "829", "849"},
+	"GD": {"473"},
+	"GU": {"671"},
+	"JM": {"658", "876"},
+	"KN": {"869"},
+	"KY": {"345"},
+	"LC": {"758"},
+	"MP": {"670"},
+	"MS": {"664"},
+	"PR": {"787", "939"},
+	"SX": {"721"},
+	"TC": {"649"},
+	"TT": {"868"},
+	"VC": {"784"},
+	"VG": {"284"},
+	"VI": {"340"},
+}
@@ -0,0 +1,67 @@
+package i18n
+
+import (
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestPhoneNumber(t *testing.T) {
+	us, _ := LookupCountry(nil, "US")
+	gb, _ := LookupCountry(nil, "GB")
+	data := map[string][]string{ // input: country, E.164, national, international
+		"+44 (0)20 7946 0958": {"GB", "+442079460958", "020 7946 0958", "+44 20 7946 0958"},
+		"0044 7700 900123":    {"GB", "+447700900123", "07700 900123", "+44 7700 900123"},
+		"+1 (416) 555-0199":   {"CA", "+14165550199", "(416) 555-0199", "+1 416-555-0199"},
+		"+1 201-555-0123":     {"US", "+12015550123", "(201) 555-0123", "+1 201-555-0123"},
+		"+1 787 555 0123":     {"PR", "+17875550123", "(787) 555-0123", "+1 787-555-0123"},
+		"+49 30 12345678":     {"DE", "+493012345678", "030 12345678", "+49 30 12345678"},
+		"+33 1 23 45 67 89":   {"FR", "+33123456789", "01 23 45 67 89", "+33 1 23 45 67 89"},
+		"+81 90-1234-5678":    {"JP", "+819012345678", "090-1234-5678", "+81 90-1234-5678"},
+		"+7 701 123 4567":     {"KZ", "+77011234567", "8 (701) 123-45-67", "+7 701 123 45 67"},
+		"+7 495 123-45-67":    {"RU", "+74951234567", "8 (495) 123-45-67", "+7 495 123-45-67"},
+		"+39 06 1234 5678":    {"IT", "+390612345678", "06 1234 5678", "+39 06 1234 5678"},
+		"+55 11 91234-5678":   {"BR", "+5511912345678", "(11) 91234-5678", "+55 11 91234-5678"},
+	}
+	for k, v := range data {
+		pn, err := ParsePhoneNumber(k, nil)
+		testing2.AssertEqual(t, err, nil)
+		testing2.AssertEqual(t, pn.Country.Alpha2Code, v[0])
+		testing2.AssertEqual(t, pn.E164(), v[1])
+		testing2.AssertEqual(t, pn.FormatNational(), v[2])
+		testing2.AssertEqual(t, pn.FormatInternational(), v[3])
+	}
+
+	// national numbers
+	pn, err := ParsePhoneNumber("020 7946 0958", gb)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, pn.String(), "+442079460958")
+	pn, err = ParsePhoneNumber("1 (201) 555-0123", us)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, pn.String(), "+12015550123")
+	// the area code decides the country of NANP numbers, not the default country
+	ca, _ := LookupCountry(nil, "CA")
+	pn, err = ParsePhoneNumber("+1 201 555 0123", ca)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, pn.Country.Alpha2Code, "US")
+	pn, err = ParsePhoneNumber("+1 416 555 0199", us)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, pn.Country.Alpha2Code, "CA")
+	pn, err = ParsePhoneNumber("011 44 20 7946 0958", us)
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, pn.Country.Alpha2Code, "GB")
+
+	// invalid numbers
+	for _, s := range []string{"", "020 7946 0958", "+44 20 7946", "+1 123 555 0123", "+999 1234567", "+44 20 ABCD 0958"} {
+		_, err = ParsePhoneNumber(s, nil)
+		testing2.AssertEqual(t, err != nil, true)
+	}
+	_, err = ParsePhoneNumber("555-0123", us)
+	testing2.AssertEqual(t, err != nil, true)
+
+	// without country
+	pn = &PhoneNumber{CallingCode: "+44", NationalNumber: "2079460958"}
+	testing2.AssertEqual(t, pn.IsValid(), false)
+	testing2.AssertEqual(t, pn.FormatNational(), "2079460958")
+	testing2.AssertEqual(t, pn.FormatInternational(), "+44 2079460958")
+}
@@ -0,0 +1,200 @@
+package i18n
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-plus/errors"
+)
+
+// The parsers parse the rates from local files (downloaded from the central banks),
+// the rates could be added to a rate table, e.g.
+//
+//	rates, err := i18n.ParseECBRatesXML(file)
+//	if err == nil {
+//		err = table.Add(rates...)
+//	}
+
+// ParseExchangeRatesCSV parses the rates in CSV with the "date,from,to,rate" format, e.g.
+//
+//	date,from,to,rate
+//	2017-05-12,EUR,USD,1.0876
+//
+// The date is in the YYYY-MM-DD format, and the header line is optional.
+// It returns an error if any currency code is invalid.
+func ParseExchangeRatesCSV(r io.Reader) ([]*ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = 4
+	reader.TrimLeadingSpace = true
+	var rates []*ExchangeRate
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Newf("exchange rates CSV is invalid (%s)", err)
+		}
+		if line == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "date") {
+			continue // header
+		}
+
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(record[0]))
+		if err != nil {
+			return nil, errors.Newf("exchange rate date %q is invalid (line %d)", record[0], line)
+		}
+		from, ok := LookupCurrency(record[1])
+		if !ok {
+			return nil, errors.Newf("currency code %q is invalid (line %d)", record[1], line)
+		}
+		to, ok := LookupCurrency(record[2])
+		if !ok {
+			return nil, errors.Newf("currency code %q is invalid (line %d)", record[2], line)
+		}
+		rate, err := parseRate(record[3])
+		if err != nil {
+			return nil, errors.Newf("exchange rate %q is invalid (line %d)", record[3], line)
+		}
+		rates = append(rates, &ExchangeRate{
+			Date: date,
+			From: from,
+			To:   to,
+			Rate: rate,
+		})
+	}
+
+	return rates, nil
+}
+
+// ecbEnvelope represents the XML document of ECB euro foreign exchange reference rates.
+type ecbEnvelope struct {
+	Days []struct {
+		Time  string `xml:"time,attr"`
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube"`
+	} `xml:"Cube>Cube"`
+}
+
+// ParseECBRatesXML parses the euro foreign exchange reference rates of European Central Bank in XML,
+// e.g. eurofxref-daily.xml, eurofxref-hist-90d.xml and eurofxref-hist.xml.
+// The rates are from EUR to other currencies, rates of the currencies which are not ISO 4217 currencies of package (e.g. the obsolete CYP) are skipped.
+func ParseECBRatesXML(r io.Reader) ([]*ExchangeRate, error) {
+	var envelope ecbEnvelope
+	if err := xml.NewDecoder(r).Decode(&envelope); err != nil {
+		return nil, errors.Newf("ECB rates XML is invalid (%s)", err)
+	}
+
+	eur := currencyTable["EUR"]
+	var rates []*ExchangeRate
+	for _, day := range envelope.Days {
+		date, err := parseECBDate(day.Time)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range day.Rates {
+			to, ok := LookupCurrency(v.Currency)
+			if !ok {
+				continue
+			}
+			rate, err := parseRate(v.Rate)
+			if err != nil {
+				return nil, errors.Newf("exchange rate %q of %s on %s is invalid", v.Rate, v.Currency, day.Time)
+			}
+			rates = append(rates, &ExchangeRate{
+				Date: date,
+				From: eur,
+				To:   to,
+				Rate: rate,
+			})
+		}
+	}
+
+	return rates, nil
+}
+
+// ParseECBRatesCSV parses the euro foreign exchange reference rates of European Central Bank in CSV,
+// e.g. eurofxref.csv and eurofxref-hist.csv, the first column is the date and the others are the rates of currencies:
+//
+//	Date, USD, JPY, BGN, CYP, ...
+//	12 May 2017, 1.0876, 123.82, 1.9558, N/A, ...
+//
+// The rates are from EUR to other currencies, rates of the currencies which are not ISO 4217 currencies of package (e.g. the obsolete CYP) are skipped.
+func ParseECBRatesCSV(r io.Reader) ([]*ExchangeRate, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil || len(header) == 0 || !strings.EqualFold(strings.TrimSpace(header[0]), "date") {
+		return nil, errors.New("ECB rates CSV is invalid (header is required)")
+	}
+	currencies := make([]*Currency, len(header))
+	for i := 1; i < len(header); i++ {
+		if curr, ok := LookupCurrency(header[i]); ok {
+			currencies[i] = curr
+		}
+	}
+
+	eur := currencyTable["EUR"]
+	var rates []*ExchangeRate
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Newf("ECB rates CSV is invalid (%s)", err)
+		}
+
+		date, err := parseECBDate(record[0])
+		if err != nil {
+			return nil, err
+		}
+		for i := 1; i < len(record) && i < len(currencies); i++ {
+			value := strings.TrimSpace(record[i])
+			if currencies[i] == nil || len(value) == 0 || value == "N/A" {
+				continue
+			}
+			rate, err := parseRate(value)
+			if err != nil {
+				return nil, errors.Newf("exchange rate %q of %s is invalid (line %d)", value, currencies[i].Code, line)
+			}
+			rates = append(rates, &ExchangeRate{
+				Date: date,
+				From: eur,
+				To:   currencies[i],
+				Rate: rate,
+			})
+		}
+	}
+
+	return rates, nil
+}
+
+// parseECBDate parses the date in the YYYY-MM-DD (e.g. 2017-05-12) or the D Month YYYY (e.g. 12 May 2017) formats.
+func parseECBDate(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	for _, layout := range []string{"2006-01-02", "2 January 2006"} {
+		if date, err := time.Parse(layout, s); err == nil {
+			return date, nil
+		}
+	}
+	return time.Time{}, errors.Newf("exchange rate date %q is invalid", s)
+}
+
+// parseRate parses the exchange rate (it should greater than 0).
+func parseRate(s string) (float64, error) {
+	rate, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, err
+	}
+	if rate <= 0 {
+		return 0, errors.Newf("exchange rate %f is invalid (it should greater than 0)", rate)
+	}
+	return rate, nil
+}
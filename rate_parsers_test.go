@@ -0,0 +1,78 @@
+package i18n
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestParseECBRates(t *testing.T) {
+	xmlData := `<?xml version="1.0" encoding="UTF-8"?>
+<gesmes:Envelope xmlns:gesmes="http://www.gesmes.org/xml/2002-08-01" xmlns="http://www.ecb.int/vocabulary/2002-08-01/eurofxref">
+	<gesmes:subject>Reference rates</gesmes:subject>
+	<gesmes:Sender>
+		<gesmes:name>European Central Bank</gesmes:name>
+	</gesmes:Sender>
+	<Cube>
+		<Cube time='2017-05-12'>
+			<Cube currency='USD' rate='1.0876'/>
+			<Cube currency='JPY' rate='123.82'/>
+		</Cube>
+		<Cube time='2017-05-11'>
+			<Cube currency='USD' rate='1.0867'/>
+			<Cube currency='CYP' rate='0.5853'/>
+		</Cube>
+	</Cube>
+</gesmes:Envelope>`
+	rates, err := ParseECBRatesXML(strings.NewReader(xmlData))
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, len(rates), 3) // CYP is skipped
+	testing2.AssertEqual(t, rates[1].From.Code, "EUR")
+	testing2.AssertEqual(t, rates[1].To.Code, "JPY")
+	testing2.AssertEqual(t, rates[1].Rate, 123.82)
+	testing2.AssertEqual(t, rates[2].Date, time.Date(2017, 5, 11, 0, 0, 0, 0, time.UTC))
+
+	_, err = ParseECBRatesXML(strings.NewReader(`<Envelope><Cube><Cube time='2017-05-12'><Cube currency='USD' rate='x'/></Cube></Cube></Envelope>`))
+	testing2.AssertEqual(t, err != nil, true)
+
+	// daily CSV
+	csvData := "Date, USD, JPY, CYP, \n12 May 2017, 1.0876, 123.82, N/A, \n"
+	rates, err = ParseECBRatesCSV(strings.NewReader(csvData))
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, len(rates), 2)
+	testing2.AssertEqual(t, rates[0].Date, time.Date(2017, 5, 12, 0, 0, 0, 0, time.UTC))
+	testing2.AssertEqual(t, rates[0].To.Code, "USD")
+
+	// historical CSV
+	csvData = "Date,USD,JPY,CYP,\n2017-05-12,1.0876,123.82,N/A,\n2007-12-31,1.4721,164.93,0.585274,\n"
+	rates, err = ParseECBRatesCSV(strings.NewReader(csvData))
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, len(rates), 4)
+
+	rt := NewRateTable(currencyTable["EUR"])
+	testing2.AssertEqual(t, rt.Add(rates...), nil)
+	rate, err := rt.Rate(currencyTable["USD"], currencyTable["JPY"], time.Date(2017, 5, 14, 0, 0, 0, 0, time.UTC))
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, strconv.FormatFloat(rate, 'f', 3, 64), "113.847") // triangulated through EUR
+
+	_, err = ParseECBRatesCSV(strings.NewReader("USD,JPY\n1.0876,123.82\n"))
+	testing2.AssertEqual(t, err != nil, true)
+	_, err = ParseECBRatesCSV(strings.NewReader("Date,USD\n2017/05/12,1.0876\n"))
+	testing2.AssertEqual(t, err != nil, true)
+}
+
+func TestParseExchangeRatesCSV(t *testing.T) {
+	rates, err := ParseExchangeRatesCSV(strings.NewReader("2017-05-12,GBP,USD,1.2885\n2017-05-12,usd,cad,1.3668\n"))
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, len(rates), 2)
+	testing2.AssertEqual(t, rates[1].From.Code, "USD")
+	testing2.AssertEqual(t, rates[1].To.Code, "CAD")
+
+	_, err = ParseExchangeRatesCSV(strings.NewReader("2017-05-12,GBP,CYP,1.2885\n"))
+	testing2.AssertEqual(t, err != nil, true)
+	_, err = ParseExchangeRatesCSV(strings.NewReader("2017-05-12,GBP,USD,-1\n"))
+	testing2.AssertEqual(t, err != nil, true)
+}
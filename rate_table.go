@@ -0,0 +1,154 @@
+package i18n
+
+import (
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-plus/errors"
+	"github.com/golang-plus/math/big"
+)
+
+// ExchangeRate represents the exchange rate of two currencies on a date.
+type ExchangeRate struct {
+	Date time.Time
+	From *Currency
+	To   *Currency
+	Rate float64 // units of To currency for 1 unit of From currency
+}
+
+// RateTable represents an in-memory table of dated exchange rates, it's an ExchangeRateProvider.
+// The rates are daily, the time of day & location of dates are ignored (only the calendar date is used).
+// It's safe for concurrent use.
+type RateTable struct {
+	mutex sync.RWMutex
+	base  *Currency
+	rates map[string][]*ExchangeRate // key: FROM/TO, value: rates sorted by date
+}
+
+// NewRateTable returns a new rate table.
+// The rates of currencies without direct (or inverse) rate are triangulated through the base currency (e.g. USD/JPY = USD/EUR * EUR/JPY).
+// No triangulation if the base is nil.
+func NewRateTable(base *Currency) *RateTable {
+	return &RateTable{
+		base:  base,
+		rates: make(map[string][]*ExchangeRate),
+	}
+}
+
+// Base returns the base currency of table.
+func (rt *RateTable) Base() *Currency {
+	return rt.base
+}
+
+// Add adds the rates to the table.
+// The rate of same currencies & date is replaced.
+func (rt *RateTable) Add(rates ...*ExchangeRate) error {
+	for _, rate := range rates {
+		if rate.From == nil || rate.To == nil {
+			return errors.New("exchange rate currency is invalid (it cannot be nil)")
+		}
+		if big.NewDecimal(rate.Rate).Sign() <= 0 {
+			return errors.Newf("exchange rate %f from %s to %s is invalid (it should greater than 0)", rate.Rate, rate.From.Code, rate.To.Code)
+		}
+	}
+
+	rt.mutex.Lock()
+	defer rt.mutex.Unlock()
+
+	for _, rate := range rates {
+		r := &ExchangeRate{
+			Date: rateDate(rate.Date),
+			From: rate.From,
+			To:   rate.To,
+			Rate: rate.Rate,
+		}
+		key := rateKey(r.From, r.To)
+		list := rt.rates[key]
+		index := sort.Search(len(list), func(i int) bool {
+			return !list[i].Date.Before(r.Date)
+		})
+		if index < len(list) && list[index].Date.Equal(r.Date) {
+			list[index] = r
+			continue
+		}
+		list = append(list, nil)
+		copy(list[index+1:], list[index:])
+		list[index] = r
+		rt.rates[key] = list
+	}
+
+	return nil
+}
+
+// Rate returns the rate for exchanging 1 unit of from currency to currency at given date.
+// It's part of ExchangeRateProvider.
+// The latest rate on or before the date is used, the inverse rate is used if no direct rate,
+// and the rate is triangulated through the base currency if no direct & inverse rates.
+func (rt *RateTable) Rate(from, to *Currency, at time.Time) (float64, error) {
+	if from.Equal(to) {
+		return 1, nil
+	}
+
+	rt.mutex.RLock()
+	defer rt.mutex.RUnlock()
+
+	date := rateDate(at)
+	if rate, ok := rt.lookup(from, to, date); ok {
+		r, _ := rate.Float64()
+		return r, nil
+	}
+	if rt.base != nil && !rt.base.Equal(from) && !rt.base.Equal(to) {
+		if r1, ok := rt.lookup(from, rt.base, date); ok {
+			if r2, ok := rt.lookup(rt.base, to, date); ok {
+				r, _ := r1.Mul(r2).Float64()
+				return r, nil
+			}
+		}
+	}
+
+	return 0, errors.Newf("exchange rate from %s to %s at %s is unavailable", from.Code, to.Code, date.Format("2006-01-02"))
+}
+
+// lookup returns the direct or inverse rate on or before the date.
+func (rt *RateTable) lookup(from, to *Currency, date time.Time) (*big.Decimal, bool) {
+	if rate, ok := lookupRate(rt.rates[rateKey(from, to)], date); ok {
+		return big.NewDecimal(rate.Rate), true
+	}
+	if rate, ok := lookupRate(rt.rates[rateKey(to, from)], date); ok {
+		return big.NewDecimal(1).Div(big.NewDecimal(rate.Rate)), true
+	}
+	return nil, false
+}
+
+// lookupRate returns the latest rate on or before the date from the sorted list.
+func lookupRate(list []*ExchangeRate, date time.Time) (*ExchangeRate, bool) {
+	index := sort.Search(len(list), func(i int) bool {
+		return list[i].Date.After(date)
+	})
+	if index == 0 {
+		return nil, false
+	}
+	return list[index-1], true
+}
+
+// LoadCSV loads the rates from CSV with the "date,from,to,rate" format (see ParseExchangeRatesCSV).
+func (rt *RateTable) LoadCSV(r io.Reader) error {
+	rates, err := ParseExchangeRatesCSV(r)
+	if err != nil {
+		return err
+	}
+	return rt.Add(rates...)
+}
+
+// rateKey returns the key of rates in rate table.
+func rateKey(from, to *Currency) string {
+	return strings.ToUpper(from.Code) + "/" + strings.ToUpper(to.Code)
+}
+
+// rateDate returns the calendar date (in UTC) of the time.
+func rateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
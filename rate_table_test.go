@@ -0,0 +1,80 @@
+package i18n
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestRateTable(t *testing.T) {
+	eur, _ := LookupCurrency("EUR")
+	usd, _ := LookupCurrency("USD")
+	jpy, _ := LookupCurrency("JPY")
+	gbp, _ := LookupCurrency("GBP")
+
+	rt := NewRateTable(eur)
+	err := rt.LoadCSV(strings.NewReader(`date,from,to,rate
+2017-05-12,EUR,USD,1.0876
+2017-05-10,EUR,USD,1.0882
+2017-05-12,EUR,JPY,123.82
+2017-05-15,EUR,USD,1.0963
+`))
+	testing2.AssertEqual(t, err, nil)
+
+	date := func(s string) time.Time {
+		d, _ := time.Parse("2006-01-02", s)
+		return d
+	}
+	data := []struct {
+		From, To *Currency
+		Date     string
+		Rate     float64
+	}{
+		{eur, usd, "2017-05-10", 1.0882},
+		{eur, usd, "2017-05-11", 1.0882}, // latest on or before
+		{eur, usd, "2017-05-12", 1.0876},
+		{eur, usd, "2017-05-14", 1.0876},
+		{eur, usd, "2017-06-01", 1.0963},
+		{eur, eur, "2000-01-01", 1},
+	}
+	for _, v := range data {
+		rate, err := rt.Rate(v.From, v.To, date(v.Date))
+		testing2.AssertEqual(t, err, nil)
+		testing2.AssertEqual(t, rate, v.Rate)
+	}
+
+	// inverse
+	rate, err := rt.Rate(usd, eur, date("2017-05-15"))
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, strconv.FormatFloat(rate, 'f', 6, 64), "0.912159")
+
+	// time of day is ignored
+	rate, err = rt.Rate(eur, usd, time.Date(2017, 5, 12, 23, 59, 0, 0, time.FixedZone("UTC+8", 8*3600)))
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, rate, 1.0876)
+
+	// triangulation
+	money, err := MustNewMoney(MustNewExchangeableCurrency("USD", 1), 100).ExchangeAt(rt, jpy, date("2017-05-12"))
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, money.String(), "JPY 11384.7003")
+
+	// unavailable
+	_, err = rt.Rate(eur, usd, date("2017-05-09"))
+	testing2.AssertEqual(t, err != nil, true)
+	_, err = rt.Rate(usd, gbp, date("2017-05-12"))
+	testing2.AssertEqual(t, err != nil, true)
+
+	// replaced
+	testing2.AssertEqual(t, rt.Add(&ExchangeRate{Date: date("2017-05-12"), From: eur, To: usd, Rate: 1.09}), nil)
+	rate, _ = rt.Rate(eur, usd, date("2017-05-12"))
+	testing2.AssertEqual(t, rate, 1.09)
+
+	// invalid
+	testing2.AssertEqual(t, rt.Add(&ExchangeRate{Date: date("2017-05-12"), From: eur, To: usd, Rate: 0}) != nil, true)
+	testing2.AssertEqual(t, rt.LoadCSV(strings.NewReader("2017-05-12,EUR,XYZ,1.5\n")) != nil, true)
+	testing2.AssertEqual(t, rt.LoadCSV(strings.NewReader("12/05/2017,EUR,USD,1.5\n")) != nil, true)
+	testing2.AssertEqual(t, rt.LoadCSV(strings.NewReader("2017-05-12,EUR,USD\n")) != nil, true)
+}
@@ -0,0 +1,191 @@
+package i18n
+
+import (
+	"sort"
+	"strings"
+)
+
+// Region represents a geographic region. UN M.49
+type Region struct {
+	Code   string // UN M.49 numeric code of region, e.g. 150
+	Name   *MultiLanguageString
+	Parent *Region // parent region (nil if it's the world)
+}
+
+// Equal reports whether two regions are same.
+// It compares the code.
+func (x *Region) Equal(y *Region) bool {
+	return x.Code == y.Code
+}
+
+// SubRegions returns the direct sub-regions of the region.
+func (r *Region) SubRegions() Regions {
+	list := regionTableChildren[r.Code]
+	if len(list) == 0 {
+		return nil
+	}
+
+	regions := make(Regions, len(list))
+	copy(regions, list)
+	return regions
+}
+
+// Countries returns the list of countries in the region (includes the countries of sub-regions).
+func (r *Region) Countries() Countries {
+	list := regionTableCountries[r.Code]
+	if len(list) == 0 {
+		return nil
+	}
+
+	countries := make(Countries, len(list))
+	copy(countries, list)
+	return countries
+}
+
+// Contains reports whether the region is the given region or one of its ancestors.
+func (r *Region) Contains(region *Region) bool {
+	for p := region; p != nil; p = p.Parent {
+		if r.Equal(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// Regions represents a sortable collection of Region.
+type Regions []*Region
+
+// SortByCode sorts the list by code.
+func (r Regions) SortByCode() {
+	var byCode RegionLessFunc = func(r1, r2 *Region) bool {
+		return r1.Code < r2.Code
+	}
+
+	byCode.Sort(r)
+}
+
+// SortByName sorts the list by name with the collation of language.
+func (r Regions) SortByName(language *Language) {
+	newLanguageCollator(language).sortByKeys(len(r), func(i int) (string, bool) {
+		if r[i] == nil {
+			return "", false
+		}
+		return r[i].Name.Value(language), true
+	}, func(i, j int) {
+		r[i], r[j] = r[j], r[i]
+	})
+}
+
+// RegionLessFunc represents the less function for sorting regions.
+type RegionLessFunc func(r1, r2 *Region) bool
+
+// Sort is a method on the function type that sorts the argument slic stably according to the function.
+func (rlf RegionLessFunc) Sort(list Regions) {
+	sorter := &regionSorter{
+		List:     list,
+		LessFunc: rlf, // The sort method's receiver is the function (closure) that defines the sort order.
+	}
+
+	sort.Stable(sorter)
+}
+
+// regionSorter joins a RegionLessFunc function and Regions to be sorted.
+type regionSorter struct {
+	List     Regions
+	LessFunc RegionLessFunc // Closure used in the Less method.
+}
+
+// Len is part of sort.Interface.
+func (rs regionSorter) Len() int {
+	return len(rs.List)
+}
+
+// Swap is part of sort.Interface.
+func (rs regionSorter) Swap(i, j int) {
+	rs.List[i], rs.List[j] = rs.List[j], rs.List[i]
+}
+
+// Less is part of sort.Interface. It is implemented by calling the "less" closure in the regionSorter.
+func (rs regionSorter) Less(i, j int) bool {
+	return rs.LessFunc(rs.List[i], rs.List[j])
+}
+
+var (
+	regionTable          map[string]*Region
+	regionTableChildren  map[string]Regions   // key: parent region code
+	regionTableCountries map[string]Countries // key: region code
+	regionTableCountry   map[string]*Region   // key: country alpha-2 code
+	regionList           Regions
+)
+
+func init() {
+	regionTable = make(map[string]*Region)
+	regionTableChildren = make(map[string]Regions)
+	regionTableCountries = make(map[string]Countries)
+	regionTableCountry = make(map[string]*Region)
+	regionList = make(Regions, len(regionCodes))
+	english, _ := LookupLanguage("en")
+	for i, codes := range regionCodes {
+		region := &Region{
+			Code: codes[0],
+			Name: NewMultiLanguageString(),
+		}
+		region.Name.SetValue(english, codes[1])
+
+		regionTable[region.Code] = region
+		regionList[i] = region
+	}
+	for _, codes := range regionCodes {
+		if parent, ok := regionTable[codes[2]]; ok {
+			region := regionTable[codes[0]]
+			region.Parent = parent
+			regionTableChildren[parent.Code] = append(regionTableChildren[parent.Code], region)
+		}
+	}
+	// countries
+	for _, country := range countryList {
+		region, ok := regionTable[countryRegionCodes[country.Alpha2Code]]
+		if !ok {
+			continue
+		}
+
+		regionTableCountry[country.Alpha2Code] = region
+		for p := region; p != nil; p = p.Parent {
+			regionTableCountries[p.Code] = append(regionTableCountries[p.Code], country)
+		}
+	}
+	// cultures
+	for _, culture := range cultureList {
+		if culture.Country != nil {
+			culture.Region = regionTableCountry[culture.Country.Alpha2Code]
+			continue
+		}
+
+		culture.Region = regionTable[culture.Code[strings.LastIndex(culture.Code, "-")+1:]]
+	}
+}
+
+// Region returns the region (UN M.49 sub-region) of the country.
+// It returns the world region for the country without sub-region (e.g. Antarctica).
+func (c *Country) Region() *Region {
+	return regionTableCountry[c.Alpha2Code]
+}
+
+// AllRegions returns the list of all regions.
+// The list is a copy, it's safe to sort or modify the list (but not the regions in it).
+func AllRegions() Regions {
+	list := make(Regions, len(regionList))
+	copy(list, regionList)
+	return list
+}
+
+// LookupRegion returns the region by given UN M.49 numeric code (e.g. 150).
+func LookupRegion(code string) (*Region, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) > 0 {
+		if region, ok := regionTable[code]; ok {
+			return region, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,288 @@
+package i18n
+
+// Region Codes UN M.49 (code, english name, parent code)
+var regionCodes = [][]string{
+	{"001", "World", ""},
+	{"002", "Africa", "001"},
+	{"015", "Northern Africa", "002"},
+	{"202", "Sub-Saharan Africa", "002"},
+	{"014", "Eastern Africa", "202"},
+	{"017", "Middle Africa", "202"},
+	{"018", "Southern Africa", "202"},
+	{"011", "Western Africa", "202"},
+	{"019", "Americas", "001"},
+	{"419", "Latin America and the Caribbean", "019"},
+	{"029", "Caribbean", "419"},
+	{"013", "Central America", "419"},
+	{"005", "South America", "419"},
+	{"021", "Northern America", "019"},
+	{"142", "Asia", "001"},
+	{"143", "Central Asia", "142"},
+	{"030", "Eastern Asia", "142"},
+	{"035", "South-eastern Asia", "142"},
+	{"034", "Southern Asia", "142"},
+	{"145", "Western Asia", "142"},
+	{"150", "Europe", "001"},
+	{"151", "Eastern Europe", "150"},
+	{"154", "Northern Europe", "150"},
+	{"039", "Southern Europe", "150"},
+	{"155", "Western Europe", "150"},
+	{"009", "Oceania", "001"},
+	{"053", "Australia and New Zealand", "009"},
+	{"054", "Melanesia", "009"},
+	{"057", "Micronesia", "009"},
+	{"061", "Polynesia", "009"},
+}
+
+// region codes (UN M.49) for country (alpha-2 code).
+var countryRegionCodes = map[string]string{
+	"AF": "034",
+	"AX": "154",
+	"AL": "039",
+	"DZ": "015",
+	"AS": "061",
+	"AD": "039",
+	"AO": "017",
+	"AI": "029",
+	"AQ": "001",
+	"AG": "029",
+	"AR": "005",
+	"AM": "145",
+	"AW": "029",
+	"AU": "053",
+	"AT": "155",
+	"AZ": "145",
+	"BS": "029",
+	"BH": "145",
+	"BD": "034",
+	"BB": "029",
+	"BY": "151",
+	"BE": "155",
+	"BZ": "013",
+	"BJ": "011",
+	"BM": "021",
+	"BT": "034",
+	"BO": "005",
+	"BQ": "029",
+	"BA": "039",
+	"BW": "018",
+	"BV": "005",
+	"BR": "005",
+	"IO": "014",
+	"BN": "035",
+	"BG": "151",
+	"BF": "011",
+	"BI": "014",
+	"CV": "011",
+	"KH": "035",
+	"CM": "017",
+	"CA": "021",
+	"KY": "029",
+	"CF": "017",
+	"TD": "017",
+	"CL": "005",
+	"CN": "030",
+	"CX": "053",
+	"CC": "053",
+	"CO": "005",
+	"KM": "014",
+	"CG": "017",
+	"CD": "017",
+	"CK": "061",
+	"CR": "013",
+	"CI": "011",
+	"HR": "039",
+	"CU": "029",
+	"CW": "029",
+	"CY": "145",
+	"CZ": "151",
+	"DK": "154",
+	"DJ": "014",
+	"DM": "029",
+	"DO": "029",
+	"EC": "005",
+	"EG": "015",
+	"SV": "013",
+	"GQ": "017",
+	"ER": "014",
+	"EE": "154",
+	"ET": "014",
+	"FK": "005",
+	"FO": "154",
+	"FJ": "054",
+	"FI": "154",
+	"FR": "155",
+	"GF": "005",
+	"PF": "061",
+	"TF": "014",
+	"GA": "017",
+	"GM": "011",
+	"GE": "145",
+	"DE": "155",
+	"GH": "011",
+	"GI": "039",
+	"GR": "039",
+	"GL": "021",
+	"GD": "029",
+	"GP": "029",
+	"GU": "057",
+	"GT": "013",
+	"GG": "154",
+	"GN": "011",
+	"GW": "011",
+	"GY": "005",
+	"HT": "029",
+	"HM": "053",
+	"VA": "039",
+	"HN": "013",
+	"HK": "030",
+	"HU": "151",
+	"IS": "154",
+	"IN": "034",
+	"ID": "035",
+	"IR": "034",
+	"IQ": "145",
+	"IE": "154",
+	"IM": "154",
+	"IL": "145",
+	"IT": "039",
+	"JM": "029",
+	"JP": "030",
+	"JE": "154",
+	"JO": "145",
+	"KZ": "143",
+	"KE": "014",
+	"KI": "057",
+	"KP": "030",
+	"KR": "030",
+	"KW": "145",
+	"KG": "143",
+	"LA": "035",
+	"LV": "154",
+	"LB": "145",
+	"LS": "018",
+	"LR": "011",
+	"LY": "015",
+	"LI": "155",
+	"LT": "154",
+	"LU": "155",
+	"MO": "030",
+	"MK": "039",
+	"MG": "014",
+	"MW": "014",
+	"MY": "035",
+	"MV": "034",
+	"ML": "011",
+	"MT": "039",
+	"MH": "057",
+	"MQ": "029",
+	"MR": "011",
+	"MU": "014",
+	"YT": "014",
+	"MX": "013",
+	"FM": "057",
+	"MD": "151",
+	"MC": "155",
+	"MN": "030",
+	"ME": "039",
+	"MS": "029",
+	"MA": "015",
+	"MZ": "014",
+	"MM": "035",
+	"NA": "018",
+	"NR": "057",
+	"NP": "034",
+	"NL": "155",
+	"NC": "054",
+	"NZ": "053",
+	"NI": "013",
+	"NE": "011",
+	"NG": "011",
+	"NU": "061",
+	"NF": "053",
+	"MP": "057",
+	"NO": "154",
+	"OM": "145",
+	"PK": "034",
+	"PW": "057",
+	"PS": "145",
+	"PA": "013",
+	"PG": "054",
+	"PY": "005",
+	"PE": "005",
+	"PH": "035",
+	"PN": "061",
+	"PL": "151",
+	"PT": "039",
+	"PR": "029",
+	"QA": "145",
+	"RE": "014",
+	"RO": "151",
+	"RU": "151",
+	"RW": "014",
+	"BL": "029",
+	"SH": "011",
+	"KN": "029",
+	"LC": "029",
+	"MF": "029",
+	"PM": "021",
+	"VC": "029",
+	"WS": "061",
+	"SM": "039",
+	"ST": "017",
+	"SA": "145",
+	"SN": "011",
+	"RS": "039",
+	"SC": "014",
+	"SL": "011",
+	"SG": "035",
+	"SX": "029",
+	"SK": "151",
+	"SI": "039",
+	"SB": "054",
+	"SO": "014",
+	"ZA": "018",
+	"GS": "005",
+	"SS": "014",
+	"ES": "039",
+	"LK": "034",
+	"SD": "015",
+	"SR": "005",
+	"SJ": "154",
+	"SZ": "018",
+	"SE": "154",
+	"CH": "155",
+	"SY": "145",
+	"TW": "030",
+	"TJ": "143",
+	"TZ": "014",
+	"TH": "035",
+	"TL": "035",
+	"TG": "011",
+	"TK": "061",
+	"TO": "061",
+	"TT": "029",
+	"TN": "015",
+	"TR": "145",
+	"TM": "143",
+	"TC": "029",
+	"TV": "061",
+	"UG": "014",
+	"UA": "151",
+	"AE": "145",
+	"GB": "154",
+	"US": "021",
+	"UM": "057",
+	"UY": "005",
+	"UZ": "143",
+	"VU": "054",
+	"VE": "005",
+	"VN": "035",
+	"VG": "029",
+	"VI": "029",
+	"WF": "061",
+	"EH": "015",
+	"YE": "145",
+	"ZM": "014",
+	"ZW": "014",
+}
@@ -0,0 +1,41 @@
+package i18n
+
+import (
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestRegion(t *testing.T) {
+	english, _ := LookupLanguage("en")
+
+	// test LookupRegion
+	europe, ok := LookupRegion("150")
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, europe.Name.Value(english), "Europe")
+	testing2.AssertEqual(t, europe.Parent.Code, "001")
+	_, ok = LookupRegion("999")
+	testing2.AssertEqual(t, ok, false)
+
+	// test Country.Region
+	de, _ := LookupCountry(nil, "DE")
+	testing2.AssertEqual(t, de.Region().Code, "155")
+	testing2.AssertEqual(t, europe.Contains(de.Region()), true)
+	aq, _ := LookupCountry(nil, "AQ")
+	testing2.AssertEqual(t, aq.Region().Code, "001")
+
+	// test Region.Countries & SubRegions
+	westernEurope, _ := LookupRegion("155")
+	testing2.AssertEqual(t, len(westernEurope.Countries()), 9)
+	testing2.AssertEqual(t, len(europe.SubRegions()), 4)
+	world, _ := LookupRegion("001")
+	testing2.AssertEqual(t, len(world.Countries()), len(AllCountries()))
+
+	// test culture with region
+	es419, ok := LookupCulture("es-419")
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, es419.Country == nil, true)
+	testing2.AssertEqual(t, es419.Region.Code, "419")
+	enUS, _ := LookupCulture("en-US")
+	testing2.AssertEqual(t, enUS.Region.Code, "021")
+}
@@ -0,0 +1,224 @@
+package i18n
+
+import (
+	"database/sql/driver"
+	"strconv"
+	"strings"
+
+	"github.com/golang-plus/errors"
+)
+
+// The entities are stored as their codes in database (see encoding.go),
+// they implement the sql.Scanner & driver.Valuer interfaces.
+
+// scanString returns the string value of src (the source value of sql.Scanner).
+func scanString(src interface{}, name string) (string, error) {
+	switch v := src.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case nil:
+		return "", errors.Newf("%s cannot be scanned from NULL", name)
+	}
+	return "", errors.Newf("%s cannot be scanned from %T", name, src)
+}
+
+// Value implements the driver.Valuer interface.
+func (c *Culture) Value() (driver.Value, error) {
+	if c == nil {
+		return nil, nil
+	}
+	return c.Code, nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *Culture) Scan(src interface{}) error {
+	s, err := scanString(src, "culture")
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+// Value implements the driver.Valuer interface.
+func (c *Country) Value() (driver.Value, error) {
+	if c == nil {
+		return nil, nil
+	}
+	return c.Alpha2Code, nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *Country) Scan(src interface{}) error {
+	s, err := scanString(src, "country")
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+// Value implements the driver.Valuer interface.
+func (c *Currency) Value() (driver.Value, error) {
+	if c == nil {
+		return nil, nil
+	}
+	return c.Code, nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *Currency) Scan(src interface{}) error {
+	s, err := scanString(src, "currency")
+	if err != nil {
+		return err
+	}
+	return c.UnmarshalText([]byte(s))
+}
+
+// Value implements the driver.Valuer interface.
+func (l *Language) Value() (driver.Value, error) {
+	if l == nil {
+		return nil, nil
+	}
+	return l.Code, nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (l *Language) Scan(src interface{}) error {
+	s, err := scanString(src, "language")
+	if err != nil {
+		return err
+	}
+	return l.UnmarshalText([]byte(s))
+}
+
+// Value implements the driver.Valuer interface.
+// It always returns an error, the code of currency cannot keep the rate (store the code of Currency instead).
+func (c ExchangeableCurrency) Value() (driver.Value, error) {
+	return nil, errors.New("exchangeable currency cannot be stored (the rate would be lost)")
+}
+
+// Scan implements the sql.Scanner interface.
+// It always returns an error, the rate cannot be scanned from the code (scan a *Currency instead).
+func (c *ExchangeableCurrency) Scan(src interface{}) error {
+	return errors.New("exchangeable currency cannot be scanned (the rate is unavailable)")
+}
+
+// Value implements the driver.Valuer interface.
+// The money is stored as a string of currency code & amount, e.g. EUR 1234.5000 (see String).
+// Use Columns to store the amount & currency in separate columns.
+func (m *Money) Value() (driver.Value, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return m.String(), nil
+}
+
+// Scan implements the sql.Scanner interface.
+// The rate of currency is unknown (0) unless the money has the same currency already (the rate is kept).
+func (m *Money) Scan(src interface{}) error {
+	s, err := scanString(src, "money")
+	if err != nil {
+		return err
+	}
+	fields := strings.Fields(s)
+	if len(fields) != 2 {
+		return errors.Newf("money %q is invalid (it should be currency code + amount)", s)
+	}
+	if err := m.scanCurrency(fields[0]); err != nil {
+		return err
+	}
+	return m.scanAmount(fields[1])
+}
+
+// scanCurrency sets the currency of money by code.
+func (m *Money) scanCurrency(code string) error {
+	curr, ok := LookupCurrency(code)
+	if !ok {
+		return errors.Newf("currency code %q is invalid", code)
+	}
+	if m.Currency == nil || !m.Currency.Currency.Equal(curr) {
+		m.Currency = &ExchangeableCurrency{
+			Currency: curr,
+		}
+	}
+	if m.Precision == 0 {
+		m.Precision = DefaultMoneyPrecision
+	}
+	if m.RoundingMode == 0 {
+		m.RoundingMode = DefaultMoneyRoundingMode
+	}
+	return nil
+}
+
+// scanAmount sets the amount of money by the decimal string.
+func (m *Money) scanAmount(s string) error {
+	amount, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return errors.Newf("money amount %q is invalid", s)
+	}
+	m.Amount = amount
+	return nil
+}
+
+// Columns returns the separate amount & currency columns of money, e.g.
+//
+//	amount, currency := money.Columns()
+//	db.Exec("INSERT INTO orders (amount, currency) VALUES (?, ?)", amount, currency)
+//	db.QueryRow("SELECT amount, currency FROM orders WHERE id = ?", id).Scan(amount, currency)
+//
+// The amount is stored as a decimal string (for DECIMAL / NUMERIC columns) to avoid the error of floating-point numbers.
+func (m *Money) Columns() (*MoneyAmountColumn, *MoneyCurrencyColumn) {
+	return &MoneyAmountColumn{money: m}, &MoneyCurrencyColumn{money: m}
+}
+
+// MoneyAmountColumn represents the amount column of money.
+// It implements the sql.Scanner & driver.Valuer interfaces.
+type MoneyAmountColumn struct {
+	money *Money
+}
+
+// Value implements the driver.Valuer interface.
+func (c *MoneyAmountColumn) Value() (driver.Value, error) {
+	return strconv.FormatFloat(c.money.Amount, 'f', int(c.money.Precision), 64), nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *MoneyAmountColumn) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case float64:
+		c.money.Amount = v
+		return nil
+	case int64:
+		c.money.Amount = float64(v)
+		return nil
+	}
+	s, err := scanString(src, "money amount")
+	if err != nil {
+		return err
+	}
+	return c.money.scanAmount(s)
+}
+
+// MoneyCurrencyColumn represents the currency (code) column of money.
+// It implements the sql.Scanner & driver.Valuer interfaces.
+type MoneyCurrencyColumn struct {
+	money *Money
+}
+
+// Value implements the driver.Valuer interface.
+func (c *MoneyCurrencyColumn) Value() (driver.Value, error) {
+	if c.money.Currency == nil {
+		return nil, nil
+	}
+	return c.money.Currency.Code, nil
+}
+
+// Scan implements the sql.Scanner interface.
+func (c *MoneyCurrencyColumn) Scan(src interface{}) error {
+	s, err := scanString(src, "money currency")
+	if err != nil {
+		return err
+	}
+	return c.money.scanCurrency(s)
+}
@@ -0,0 +1,68 @@
+package i18n
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+// assert interfaces
+var (
+	_ sql.Scanner   = new(Culture)
+	_ driver.Valuer = new(Culture)
+	_ sql.Scanner   = new(Money)
+	_ driver.Valuer = new(Money)
+)
+
+func TestSQL(t *testing.T) {
+	cult, _ := LookupCulture("en-US")
+	value, err := cult.Value()
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, value, "en-US")
+
+	var country Country
+	testing2.AssertEqual(t, country.Scan([]byte("de")), nil)
+	testing2.AssertEqual(t, country.Alpha2Code, "DE")
+	testing2.AssertEqual(t, country.Scan(nil) != nil, true)
+	var curr Currency
+	testing2.AssertEqual(t, curr.Scan("XYZ") != nil, true)
+	testing2.AssertEqual(t, curr.Scan("jpy"), nil)
+	testing2.AssertEqual(t, curr.Code, "JPY")
+	var lang Language
+	testing2.AssertEqual(t, lang.Scan(int64(1)) != nil, true)
+
+	// money (composite)
+	money := MustNewMoney(MustNewExchangeableCurrency("EUR", 0.14), 1234.5)
+	value, err = money.Value()
+	testing2.AssertEqual(t, err, nil)
+	testing2.AssertEqual(t, value, "EUR 1234.5000")
+	var m Money
+	testing2.AssertEqual(t, m.Scan([]byte("EUR 1234.5000")), nil)
+	testing2.AssertEqual(t, m.String(), "EUR 1234.5000")
+	testing2.AssertEqual(t, m.Currency.Rate, 0.0) // unknown rate
+	func() {
+		defer func() {
+			_, ok := recover().(*CurrencyMismatchError)
+			testing2.AssertEqual(t, ok, true)
+		}()
+		m.Plus(MustNewMoney(MustNewExchangeableCurrency("USD", 0.15), 1)) // mixing currencies by the unknown rate
+	}()
+	testing2.AssertEqual(t, m.Scan("1234.50") != nil, true)
+	testing2.AssertEqual(t, m.Scan("EUR abc") != nil, true)
+
+	// money (separate columns)
+	amount, currency := money.Columns()
+	value, _ = amount.Value()
+	testing2.AssertEqual(t, value, "1234.5000")
+	value, _ = currency.Value()
+	testing2.AssertEqual(t, value, "EUR")
+	testing2.AssertEqual(t, amount.Scan([]byte("99.99")), nil)
+	testing2.AssertEqual(t, currency.Scan("eur"), nil)
+	testing2.AssertEqual(t, money.String(), "EUR 99.9900")
+	testing2.AssertEqual(t, money.Currency.Rate, 0.14) // same currency, rate is kept
+	testing2.AssertEqual(t, amount.Scan(int64(5)), nil)
+	testing2.AssertEqual(t, currency.Scan("USD"), nil)
+	testing2.AssertEqual(t, money.String(), "USD 5.0000")
+}
@@ -0,0 +1,142 @@
+package i18n
+
+import (
+	"sort"
+	"strings"
+)
+
+// Subdivision represents a principal subdivision of a country (e.g. state, province). ISO 3166-2
+type Subdivision struct {
+	Code    string // ISO 3166-2 code in the XX-YYY format(XX:country alpha-2 code, YYY:subdivision code), e.g. US-CA
+	Name    string // ISO name of subdivision
+	Type    string // type of subdivision, e.g. state, province
+	Country *Country
+	Parent  *Subdivision // parent subdivision (nil if it's a top-level subdivision)
+}
+
+// Equal reports whether two subdivisions are same.
+// It compares the code.
+func (x *Subdivision) Equal(y *Subdivision) bool {
+	return strings.EqualFold(x.Code, y.Code)
+}
+
+// Subdivisions represents a sortable collection of Subdivision.
+type Subdivisions []*Subdivision
+
+// SortByCode sorts the list by code.
+func (s Subdivisions) SortByCode() {
+	var byCode SubdivisionLessFunc = func(s1, s2 *Subdivision) bool {
+		return s1.Code < s2.Code
+	}
+
+	byCode.Sort(s)
+}
+
+// SortByName sorts the list by name.
+func (s Subdivisions) SortByName() {
+	rootCollator.sortByKeys(len(s), func(i int) (string, bool) {
+		if s[i] == nil {
+			return "", false
+		}
+		return s[i].Name, true
+	}, func(i, j int) {
+		s[i], s[j] = s[j], s[i]
+	})
+}
+
+// SubdivisionLessFunc represents the less function for sorting subdivisions.
+type SubdivisionLessFunc func(s1, s2 *Subdivision) bool
+
+// Sort is a method on the function type that sorts the argument slic stably according to the function.
+func (slf SubdivisionLessFunc) Sort(list Subdivisions) {
+	sorter := &subdivisionSorter{
+		List:     list,
+		LessFunc: slf, // The sort method's receiver is the function (closure) that defines the sort order.
+	}
+
+	sort.Stable(sorter)
+}
+
+// subdivisionSorter joins a SubdivisionLessFunc function and Subdivisions to be sorted.
+type subdivisionSorter struct {
+	List     Subdivisions
+	LessFunc SubdivisionLessFunc // Closure used in the Less method.
+}
+
+// Len is part of sort.Interface.
+func (ss subdivisionSorter) Len() int {
+	return len(ss.List)
+}
+
+// Swap is part of sort.Interface.
+func (ss subdivisionSorter) Swap(i, j int) {
+	ss.List[i], ss.List[j] = ss.List[j], ss.List[i]
+}
+
+// Less is part of sort.Interface. It is implemented by calling the "less" closure in the subdivisionSorter.
+func (ss subdivisionSorter) Less(i, j int) bool {
+	return ss.LessFunc(ss.List[i], ss.List[j])
+}
+
+var (
+	subdivisionTable        map[string]*Subdivision
+	subdivisionTableCountry map[string]Subdivisions // key: country alpha-2 code
+)
+
+func init() {
+	subdivisionTable = make(map[string]*Subdivision)
+	subdivisionTableCountry = make(map[string]Subdivisions)
+	for _, codes := range subdivisionCodes {
+		code := codes[0]
+		country, ok := countryTableAlpha2[code[0:strings.Index(code, "-")]]
+		if !ok {
+			continue
+		}
+		subdivision := &Subdivision{
+			Code:    code,
+			Name:    codes[1],
+			Type:    codes[2],
+			Country: country,
+		}
+
+		subdivisionTable[code] = subdivision
+		subdivisionTableCountry[country.Alpha2Code] = append(subdivisionTableCountry[country.Alpha2Code], subdivision)
+	}
+	// parents
+	for _, codes := range subdivisionCodes {
+		if subdivision, ok := subdivisionTable[codes[0]]; ok && len(codes[3]) > 0 {
+			subdivision.Parent = subdivisionTable[codes[3]]
+		}
+	}
+}
+
+// HasSubdivisionData reports whether the subdivision data covers the country.
+// The subdivision data covers a limited set of countries only (AU, BR, CA, CN, DE, ES, GB, JP, MX and US).
+func (c *Country) HasSubdivisionData() bool {
+	return len(subdivisionTableCountry[c.Alpha2Code]) > 0
+}
+
+// Subdivisions returns the list of subdivisions of the country.
+// It returns nil if the subdivision data does not cover the country (see HasSubdivisionData),
+// even if the country has subdivisions in ISO 3166-2.
+func (c *Country) Subdivisions() Subdivisions {
+	list := subdivisionTableCountry[c.Alpha2Code]
+	if len(list) == 0 {
+		return nil
+	}
+
+	subdivisions := make(Subdivisions, len(list))
+	copy(subdivisions, list)
+	return subdivisions
+}
+
+// LookupSubdivision returns the subdivision by given code (e.g. US-CA).
+func LookupSubdivision(code string) (*Subdivision, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) > 0 {
+		if subdivision, ok := subdivisionTable[strings.ToUpper(code)]; ok {
+			return subdivision, true
+		}
+	}
+	return nil, false
+}
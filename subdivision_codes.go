@@ -0,0 +1,310 @@
+package i18n
+
+// Subdivision Codes ISO 3166-2 (code, name, type, parent code)
+// Only the subdivisions of AU, BR, CA, CN, DE, ES, GB, JP, MX and US are included.
+var subdivisionCodes = [][]string{
+	{"AU-ACT", "Australian Capital Territory", "territory", ""},
+	{"AU-NSW", "New South Wales", "state", ""},
+	{"AU-NT", "Northern Territory", "territory", ""},
+	{"AU-QLD", "Queensland", "state", ""},
+	{"AU-SA", "South Australia", "state", ""},
+	{"AU-TAS", "Tasmania", "state", ""},
+	{"AU-VIC", "Victoria", "state", ""},
+	{"AU-WA", "Western Australia", "state", ""},
+	{"BR-AC", "Acre", "state", ""},
+	{"BR-AL", "Alagoas", "state", ""},
+	{"BR-AM", "Amazonas", "state", ""},
+	{"BR-AP", "Amapá", "state", ""},
+	{"BR-BA", "Bahia", "state", ""},
+	{"BR-CE", "Ceará", "state", ""},
+	{"BR-DF", "Distrito Federal", "federal district", ""},
+	{"BR-ES", "Espírito Santo", "state", ""},
+	{"BR-GO", "Goiás", "state", ""},
+	{"BR-MA", "Maranhão", "state", ""},
+	{"BR-MG", "Minas Gerais", "state", ""},
+	{"BR-MS", "Mato Grosso do Sul", "state", ""},
+	{"BR-MT", "Mato Grosso", "state", ""},
+	{"BR-PA", "Pará", "state", ""},
+	{"BR-PB", "Paraíba", "state", ""},
+	{"BR-PE", "Pernambuco", "state", ""},
+	{"BR-PI", "Piauí", "state", ""},
+	{"BR-PR", "Paraná", "state", ""},
+	{"BR-RJ", "Rio de Janeiro", "state", ""},
+	{"BR-RN", "Rio Grande do Norte", "state", ""},
+	{"BR-RO", "Rondônia", "state", ""},
+	{"BR-RR", "Roraima", "state", ""},
+	{"BR-RS", "Rio Grande do Sul", "state", ""},
+	{"BR-SC", "Santa Catarina", "state", ""},
+	{"BR-SE", "Sergipe", "state", ""},
+	{"BR-SP", "São Paulo", "state", ""},
+	{"BR-TO", "Tocantins", "state", ""},
+	{"CA-AB", "Alberta", "province", ""},
+	{"CA-BC", "British Columbia", "province", ""},
+	{"CA-MB", "Manitoba", "province", ""},
+	{"CA-NB", "New Brunswick", "province", ""},
+	{"CA-NL", "Newfoundland and Labrador", "province", ""},
+	{"CA-NS", "Nova Scotia", "province", ""},
+	{"CA-NT", "Northwest Territories", "territory", ""},
+	{"CA-NU", "Nunavut", "territory", ""},
+	{"CA-ON", "Ontario", "province", ""},
+	{"CA-PE", "Prince Edward Island", "province", ""},
+	{"CA-QC", "Quebec", "province", ""},
+	{"CA-SK", "Saskatchewan", "province", ""},
+	{"CA-YT", "Yukon", "territory", ""},
+	{"CN-AH", "Anhui", "province", ""},
+	{"CN-BJ", "Beijing", "municipality", ""},
+	{"CN-CQ", "Chongqing", "municipality", ""},
+	{"CN-FJ", "Fujian", "province", ""},
+	{"CN-GD", "Guangdong", "province", ""},
+	{"CN-GS", "Gansu", "province", ""},
+	{"CN-GX", "Guangxi Zhuangzu Zizhiqu", "autonomous region", ""},
+	{"CN-GZ", "Guizhou", "province", ""},
+	{"CN-HA", "Henan", "province", ""},
+	{"CN-HB", "Hubei", "province", ""},
+	{"CN-HE", "Hebei", "province", ""},
+	{"CN-HI", "Hainan", "province", ""},
+	{"CN-HL", "Heilongjiang", "province", ""},
+	{"CN-HN", "Hunan", "province", ""},
+	{"CN-JL", "Jilin", "province", ""},
+	{"CN-JS", "Jiangsu", "province", ""},
+	{"CN-JX", "Jiangxi", "province", ""},
+	{"CN-LN", "Liaoning", "province", ""},
+	{"CN-NM", "Nei Mongol Zizhiqu", "autonomous region", ""},
+	{"CN-NX", "Ningxia Huizu Zizhiqu", "autonomous region", ""},
+	{"CN-QH", "Qinghai", "province", ""},
+	{"CN-SC", "Sichuan", "province", ""},
+	{"CN-SD", "Shandong", "province", ""},
+	{"CN-SH", "Shanghai", "municipality", ""},
+	{"CN-SN", "Shaanxi", "province", ""},
+	{"CN-SX", "Shanxi", "province", ""},
+	{"CN-TJ", "Tianjin", "municipality", ""},
+	{"CN-XJ", "Xinjiang Uygur Zizhiqu", "autonomous region", ""},
+	{"CN-XZ", "Xizang Zizhiqu", "autonomous region", ""},
+	{"CN-YN", "Yunnan", "province", ""},
+	{"CN-ZJ", "Zhejiang", "province", ""},
+	{"DE-BB", "Brandenburg", "land", ""},
+	{"DE-BE", "Berlin", "land", ""},
+	{"DE-BW", "Baden-Württemberg", "land", ""},
+	{"DE-BY", "Bayern", "land", ""},
+	{"DE-HB", "Bremen", "land", ""},
+	{"DE-HE", "Hessen", "land", ""},
+	{"DE-HH", "Hamburg", "land", ""},
+	{"DE-MV", "Mecklenburg-Vorpommern", "land", ""},
+	{"DE-NI", "Niedersachsen", "land", ""},
+	{"DE-NW", "Nordrhein-Westfalen", "land", ""},
+	{"DE-RP", "Rheinland-Pfalz", "land", ""},
+	{"DE-SH", "Schleswig-Holstein", "land", ""},
+	{"DE-SL", "Saarland", "land", ""},
+	{"DE-SN", "Sachsen", "land", ""},
+	{"DE-ST", "Sachsen-Anhalt", "land", ""},
+	{"DE-TH", "Thüringen", "land", ""},
+	{"ES-AN", "Andalucía", "autonomous community", ""},
+	{"ES-AR", "Aragón", "autonomous community", ""},
+	{"ES-AS", "Asturias, Principado de", "autonomous community", ""},
+	{"ES-CB", "Cantabria", "autonomous community", ""},
+	{"ES-CE", "Ceuta", "autonomous city", ""},
+	{"ES-CL", "Castilla y León", "autonomous community", ""},
+	{"ES-CM", "Castilla-La Mancha", "autonomous community", ""},
+	{"ES-CN", "Canarias", "autonomous community", ""},
+	{"ES-CT", "Catalunya", "autonomous community", ""},
+	{"ES-EX", "Extremadura", "autonomous community", ""},
+	{"ES-GA", "Galicia", "autonomous community", ""},
+	{"ES-IB", "Illes Balears", "autonomous community", ""},
+	{"ES-MC", "Murcia, Región de", "autonomous community", ""},
+	{"ES-MD", "Madrid, Comunidad de", "autonomous community", ""},
+	{"ES-ML", "Melilla", "autonomous city", ""},
+	{"ES-NC", "Navarra, Comunidad Foral de", "autonomous community", ""},
+	{"ES-PV", "País Vasco", "autonomous community", ""},
+	{"ES-RI", "La Rioja", "autonomous community", ""},
+	{"ES-VC", "Valenciana, Comunitat", "autonomous community", ""},
+	{"ES-A", "Alicante", "province", "ES-VC"},
+	{"ES-AB", "Albacete", "province", "ES-CM"},
+	{"ES-AL", "Almería", "province", "ES-AN"},
+	{"ES-AV", "Ávila", "province", "ES-CL"},
+	{"ES-B", "Barcelona", "province", "ES-CT"},
+	{"ES-BA", "Badajoz", "province", "ES-EX"},
+	{"ES-BI", "Bizkaia", "province", "ES-PV"},
+	{"ES-BU", "Burgos", "province", "ES-CL"},
+	{"ES-C", "A Coruña", "province", "ES-GA"},
+	{"ES-CA", "Cádiz", "province", "ES-AN"},
+	{"ES-CC", "Cáceres", "province", "ES-EX"},
+	{"ES-CO", "Córdoba", "province", "ES-AN"},
+	{"ES-CR", "Ciudad Real", "province", "ES-CM"},
+	{"ES-CS", "Castellón", "province", "ES-VC"},
+	{"ES-CU", "Cuenca", "province", "ES-CM"},
+	{"ES-GC", "Las Palmas", "province", "ES-CN"},
+	{"ES-GI", "Girona", "province", "ES-CT"},
+	{"ES-GR", "Granada", "province", "ES-AN"},
+	{"ES-GU", "Guadalajara", "province", "ES-CM"},
+	{"ES-H", "Huelva", "province", "ES-AN"},
+	{"ES-HU", "Huesca", "province", "ES-AR"},
+	{"ES-J", "Jaén", "province", "ES-AN"},
+	{"ES-L", "Lleida", "province", "ES-CT"},
+	{"ES-LE", "León", "province", "ES-CL"},
+	{"ES-LO", "La Rioja", "province", "ES-RI"},
+	{"ES-LU", "Lugo", "province", "ES-GA"},
+	{"ES-M", "Madrid", "province", "ES-MD"},
+	{"ES-MA", "Málaga", "province", "ES-AN"},
+	{"ES-MU", "Murcia", "province", "ES-MC"},
+	{"ES-NA", "Navarra", "province", "ES-NC"},
+	{"ES-O", "Asturias", "province", "ES-AS"},
+	{"ES-OR", "Ourense", "province", "ES-GA"},
+	{"ES-P", "Palencia", "province", "ES-CL"},
+	{"ES-PM", "Illes Balears", "province", "ES-IB"},
+	{"ES-PO", "Pontevedra", "province", "ES-GA"},
+	{"ES-S", "Cantabria", "province", "ES-CB"},
+	{"ES-SA", "Salamanca", "province", "ES-CL"},
+	{"ES-SE", "Sevilla", "province", "ES-AN"},
+	{"ES-SG", "Segovia", "province", "ES-CL"},
+	{"ES-SO", "Soria", "province", "ES-CL"},
+	{"ES-SS", "Gipuzkoa", "province", "ES-PV"},
+	{"ES-T", "Tarragona", "province", "ES-CT"},
+	{"ES-TE", "Teruel", "province", "ES-AR"},
+	{"ES-TF", "Santa Cruz de Tenerife", "province", "ES-CN"},
+	{"ES-TO", "Toledo", "province", "ES-CM"},
+	{"ES-V", "Valencia", "province", "ES-VC"},
+	{"ES-VA", "Valladolid", "province", "ES-CL"},
+	{"ES-VI", "Araba/Álava", "province", "ES-PV"},
+	{"ES-Z", "Zaragoza", "province", "ES-AR"},
+	{"ES-ZA", "Zamora", "province", "ES-CL"},
+	{"GB-ENG", "England", "country", ""},
+	{"GB-NIR", "Northern Ireland", "province", ""},
+	{"GB-SCT", "Scotland", "country", ""},
+	{"GB-WLS", "Wales", "country", ""},
+	{"JP-01", "Hokkaido", "prefecture", ""},
+	{"JP-02", "Aomori", "prefecture", ""},
+	{"JP-03", "Iwate", "prefecture", ""},
+	{"JP-04", "Miyagi", "prefecture", ""},
+	{"JP-05", "Akita", "prefecture", ""},
+	{"JP-06", "Yamagata", "prefecture", ""},
+	{"JP-07", "Fukushima", "prefecture", ""},
+	{"JP-08", "Ibaraki", "prefecture", ""},
+	{"JP-09", "Tochigi", "prefecture", ""},
+	{"JP-10", "Gunma", "prefecture", ""},
+	{"JP-11", "Saitama", "prefecture", ""},
+	{"JP-12", "Chiba", "prefecture", ""},
+	{"JP-13", "Tokyo", "prefecture", ""},
+	{"JP-14", "Kanagawa", "prefecture", ""},
+	{"JP-15", "Niigata", "prefecture", ""},
+	{"JP-16", "Toyama", "prefecture", ""},
+	{"JP-17", "Ishikawa", "prefecture", ""},
+	{"JP-18", "Fukui", "prefecture", ""},
+	{"JP-19", "Yamanashi", "prefecture", ""},
+	{"JP-20", "Nagano", "prefecture", ""},
+	{"JP-21", "Gifu", "prefecture", ""},
+	{"JP-22", "Shizuoka", "prefecture", ""},
+	{"JP-23", "Aichi", "prefecture", ""},
+	{"JP-24", "Mie", "prefecture", ""},
+	{"JP-25", "Shiga", "prefecture", ""},
+	{"JP-26", "Kyoto", "prefecture", ""},
+	{"JP-27", "Osaka", "prefecture", ""},
+	{"JP-28", "Hyogo", "prefecture", ""},
+	{"JP-29", "Nara", "prefecture", ""},
+	{"JP-30", "Wakayama", "prefecture", ""},
+	{"JP-31", "Tottori", "prefecture", ""},
+	{"JP-32", "Shimane", "prefecture", ""},
+	{"JP-33", "Okayama", "prefecture", ""},
+	{"JP-34", "Hiroshima", "prefecture", ""},
+	{"JP-35", "Yamaguchi", "prefecture", ""},
+	{"JP-36", "Tokushima", "prefecture", ""},
+	{"JP-37", "Kagawa", "prefecture", ""},
+	{"JP-38", "Ehime", "prefecture", ""},
+	{"JP-39", "Kochi", "prefecture", ""},
+	{"JP-40", "Fukuoka", "prefecture", ""},
+	{"JP-41", "Saga", "prefecture", ""},
+	{"JP-42", "Nagasaki", "prefecture", ""},
+	{"JP-43", "Kumamoto", "prefecture", ""},
+	{"JP-44", "Oita", "prefecture", ""},
+	{"JP-45", "Miyazaki", "prefecture", ""},
+	{"JP-46", "Kagoshima", "prefecture", ""},
+	{"JP-47", "Okinawa", "prefecture", ""},
+	{"MX-AGU", "Aguascalientes", "state", ""},
+	{"MX-BCN", "Baja California", "state", ""},
+	{"MX-BCS", "Baja California Sur", "state", ""},
+	{"MX-CAM", "Campeche", "state", ""},
+	{"MX-CHH", "Chihuahua", "state", ""},
+	{"MX-CHP", "Chiapas", "state", ""},
+	{"MX-CMX", "Ciudad de México", "federal entity", ""},
+	{"MX-COA", "Coahuila de Zaragoza", "state", ""},
+	{"MX-COL", "Colima", "state", ""},
+	{"MX-DUR", "Durango", "state", ""},
+	{"MX-GRO", "Guerrero", "state", ""},
+	{"MX-GUA", "Guanajuato", "state", ""},
+	{"MX-HID", "Hidalgo", "state", ""},
+	{"MX-JAL", "Jalisco", "state", ""},
+	{"MX-MEX", "México", "state", ""},
+	{"MX-MIC", "Michoacán de Ocampo", "state", ""},
+	{"MX-MOR", "Morelos", "state", ""},
+	{"MX-NAY", "Nayarit", "state", ""},
+	{"MX-NLE", "Nuevo León", "state", ""},
+	{"MX-OAX", "Oaxaca", "state", ""},
+	{"MX-PUE", "Puebla", "state", ""},
+	{"MX-QUE", "Querétaro", "state", ""},
+	{"MX-ROO", "Quintana Roo", "state", ""},
+	{"MX-SIN", "Sinaloa", "state", ""},
+	{"MX-SLP", "San Luis Potosí", "state", ""},
+	{"MX-SON", "Sonora", "state", ""},
+	{"MX-TAB", "Tabasco", "state", ""},
+	{"MX-TAM", "Tamaulipas", "state", ""},
+	{"MX-TLA", "Tlaxcala", "state", ""},
+	{"MX-VER", "Veracruz de Ignacio de la Llave", "state", ""},
+	{"MX-YUC", "Yucatán", "state", ""},
+	{"MX-ZAC", "Zacatecas", "state", ""},
+	{"US-AK", "Alaska", "state", ""},
+	{"US-AL", "Alabama", "state", ""},
+	{"US-AR", "Arkansas", "state", ""},
+	{"US-AS", "American Samoa", "outlying area", ""},
+	{"US-AZ", "Arizona", "state", ""},
+	{"US-CA", "California", "state", ""},
+	{"US-CO", "Colorado", "state", ""},
+	{"US-CT", "Connecticut", "state", ""},
+	{"US-DC", "District of Columbia", "district", ""},
+	{"US-DE", "Delaware", "state", ""},
+	{"US-FL", "Florida", "state", ""},
+	{"US-GA", "Georgia", "state", ""},
+	{"US-GU", "Guam", "outlying area", ""},
+	{"US-HI", "Hawaii", "state", ""},
+	{"US-IA", "Iowa", "state", ""},
+	{"US-ID", "Idaho", "state", ""},
+	{"US-IL", "Illinois", "state", ""},
+	{"US-IN", "Indiana", "state", ""},
+	{"US-KS", "Kansas", "state", ""},
+	{"US-KY", "Kentucky", "state", ""},
+	{"US-LA", "Louisiana", "state", ""},
+	{"US-MA", "Massachusetts", "state", ""},
+	{"US-MD", "Maryland", "state", ""},
+	{"US-ME", "Maine", "state", ""},
+	{"US-MI", "Michigan", "state", ""},
+	{"US-MN", "Minnesota", "state", ""},
+	{"US-MO", "Missouri", "state", ""},
+	{"US-MP", "Northern Mariana Islands", "outlying area", ""},
+	{"US-MS", "Mississippi", "state", ""},
+	{"US-MT", "Montana", "state", ""},
+	{"US-NC", "North Carolina", "state", ""},
+	{"US-ND", "North Dakota", "state", ""},
+	{"US-NE", "Nebraska", "state", ""},
+	{"US-NH", "New Hampshire", "state", ""},
+	{"US-NJ", "New Jersey", "state", ""},
+	{"US-NM", "New Mexico", "state", ""},
+	{"US-NV", "Nevada", "state", ""},
+	{"US-NY", "New York", "state", ""},
+	{"US-OH", "Ohio", "state", ""},
+	{"US-OK", "Oklahoma", "state", ""},
+	{"US-OR", "Oregon", "state", ""},
+	{"US-PA", "Pennsylvania", "state", ""},
+	{"US-PR", "Puerto Rico", "outlying area", ""},
+	{"US-RI", "Rhode Island", "state", ""},
+	{"US-SC", "South Carolina", "state", ""},
+	{"US-SD", "South Dakota", "state", ""},
+	{"US-TN", "Tennessee", "state", ""},
+	{"US-TX", "Texas", "state", ""},
+	{"US-UM", "United States Minor Outlying Islands", "outlying area", ""},
+	{"US-UT", "Utah", "state", ""},
+	{"US-VA", "Virginia", "state", ""},
+	{"US-VI", "Virgin Islands, U.S.", "outlying area", ""},
+	{"US-VT", "Vermont", "state", ""},
+	{"US-WA", "Washington", "state", ""},
+	{"US-WI", "Wisconsin", "state", ""},
+	{"US-WV", "West Virginia", "state", ""},
+	{"US-WY", "Wyoming", "state", ""},
+}
@@ -0,0 +1,38 @@
+package i18n
+
+import (
+	"testing"
+
+	testing2 "github.com/golang-plus/testing"
+)
+
+func TestSubdivision(t *testing.T) {
+	// test LookupSubdivision
+	ca, ok := LookupSubdivision("us-ca")
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, ca.Code, "US-CA")
+	testing2.AssertEqual(t, ca.Name, "California")
+	testing2.AssertEqual(t, ca.Type, "state")
+	testing2.AssertEqual(t, ca.Country.Alpha2Code, "US")
+	testing2.AssertEqual(t, ca.Parent == nil, true)
+
+	b, ok := LookupSubdivision("ES-B")
+	testing2.AssertEqual(t, ok, true)
+	testing2.AssertEqual(t, b.Parent.Code, "ES-CT")
+
+	_, ok = LookupSubdivision("US-XX")
+	testing2.AssertEqual(t, ok, false)
+
+	// test Country.Subdivisions
+	us, _ := LookupCountry(nil, "US")
+	testing2.AssertEqual(t, us.HasSubdivisionData(), true)
+	testing2.AssertEqual(t, len(us.Subdivisions()), 57)
+	fr, _ := LookupCountry(nil, "FR")
+	testing2.AssertEqual(t, fr.HasSubdivisionData(), false) // not covered
+	testing2.AssertEqual(t, fr.Subdivisions() == nil, true)
+	jp, _ := LookupCountry(nil, "JP")
+	list := jp.Subdivisions()
+	list.SortByName()
+	testing2.AssertEqual(t, list[0].Name, "Aichi")
+	testing2.AssertEqual(t, jp.Subdivisions()[0].Code, "JP-01")
+}